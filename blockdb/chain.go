@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"hash/fnv"
+	"time"
 
 	"golang.org/x/sync/singleflight"
 )
@@ -30,21 +31,35 @@ func (txs transactions) Hash() []byte {
 // This method is idempotent and can be safely called multiple times with the same arguments.
 // The txs should be human-readable.
 func (chain *Chain) SaveBlock(ctx context.Context, height int64, txs []Tx) error {
-	k := fmt.Sprintf("%d-%x", height, transactions(txs).Hash())
+	return chain.SaveBlockData(ctx, Block{Height: height, Txs: txs})
+}
+
+// SaveBlockData tracks a block with its transactions, header and block-level events.
+// Like SaveBlock, this method is idempotent.
+func (chain *Chain) SaveBlockData(ctx context.Context, block Block) error {
+	k := fmt.Sprintf("%d-%x", block.Height, transactions(block.Txs).Hash())
 	_, err, _ := chain.single.Do(k, func() (any, error) {
-		return nil, chain.saveBlock(ctx, height, txs)
+		return nil, chain.saveBlock(ctx, block)
 	})
 	return err
 }
 
-func (chain *Chain) saveBlock(ctx context.Context, height int64, txs transactions) error {
+func (chain *Chain) saveBlock(ctx context.Context, block Block) error {
 	dbTx, err := chain.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 	defer func() { _ = dbTx.Rollback() }()
 
-	res, err := dbTx.ExecContext(ctx, `INSERT OR REPLACE INTO block(height, fk_chain_id, created_at) VALUES (?, ?, ?)`, height, chain.id, nowRFC3339())
+	var blockTime sql.NullString
+	if !block.Header.Time.IsZero() {
+		blockTime = sql.NullString{String: block.Header.Time.UTC().Format(time.RFC3339Nano), Valid: true}
+	}
+	res, err := dbTx.ExecContext(ctx, `INSERT OR REPLACE INTO block(height, fk_chain_id, created_at, block_time, proposer_address, app_hash, validators_hash)
+VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		block.Height, chain.id, nowRFC3339(), blockTime,
+		nullString(block.Header.ProposerAddress), nullString(block.Header.AppHash), nullString(block.Header.ValidatorsHash),
+	)
 	if err != nil {
 		return fmt.Errorf("insert into block: %w", err)
 	}
@@ -53,8 +68,10 @@ func (chain *Chain) saveBlock(ctx context.Context, height int64, txs transaction
 	if err != nil {
 		return err
 	}
-	for _, tx := range txs {
-		txRes, err := dbTx.ExecContext(ctx, `INSERT INTO tx(data, fk_block_id) VALUES (?, ?)`, string(tx.Data), blockID)
+	for _, tx := range block.Txs {
+		txRes, err := dbTx.ExecContext(ctx, `INSERT INTO tx(data, raw, decode_error, fk_block_id, gas_used, gas_wanted, code, codespace, log)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			string(tx.Data), tx.Raw, tx.DecodeError, blockID, tx.GasUsed, tx.GasWanted, tx.Code, tx.Codespace, tx.Log)
 		if err != nil {
 			return fmt.Errorf("insert into tx: %w", err)
 		}
@@ -64,24 +81,73 @@ func (chain *Chain) saveBlock(ctx context.Context, height int64, txs transaction
 		}
 
 		for _, e := range tx.Events {
-			eventRes, err := dbTx.ExecContext(ctx, `INSERT INTO tendermint_event(type, fk_tx_id) VALUES (?, ?)`, e.Type, txID)
-			if err != nil {
-				return fmt.Errorf("insert into tendermint_event: %w", err)
-			}
-
-			eventID, err := eventRes.LastInsertId()
-			if err != nil {
+			if err := insertEvent(ctx, dbTx, e, txID, nil); err != nil {
 				return err
 			}
+		}
+	}
 
-			for _, attr := range e.Attributes {
-				_, err := dbTx.ExecContext(ctx, `INSERT INTO tendermint_event_attr(key, value, fk_event_id) VALUES (?, ?, ?)`, attr.Key, attr.Value, eventID)
-				if err != nil {
-					return fmt.Errorf("insert into tendermint_event_attr: %w", err)
-				}
-			}
+	for _, e := range block.Events {
+		if err := insertEvent(ctx, dbTx, e, nil, blockID); err != nil {
+			return err
 		}
 	}
 
 	return dbTx.Commit()
 }
+
+// insertEvent inserts e, which belongs to either a tx or a block, with its attributes.
+func insertEvent(ctx context.Context, dbTx *sql.Tx, e Event, txID, blockID any) error {
+	eventRes, err := dbTx.ExecContext(ctx, `INSERT INTO tendermint_event(type, fk_tx_id, fk_block_id) VALUES (?, ?, ?)`, e.Type, txID, blockID)
+	if err != nil {
+		return fmt.Errorf("insert into tendermint_event: %w", err)
+	}
+
+	eventID, err := eventRes.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	for _, attr := range e.Attributes {
+		_, err := dbTx.ExecContext(ctx, `INSERT INTO tendermint_event_attr(key, value, fk_event_id) VALUES (?, ?, ?)`, attr.Key, attr.Value, eventID)
+		if err != nil {
+			return fmt.Errorf("insert into tendermint_event_attr: %w", err)
+		}
+	}
+	return nil
+}
+
+// MissingHeights returns the heights below the last saved block which were not saved, and the last saved height.
+// It implements HeightTracker, so that a Collector saving to chain backfills the heights.
+func (chain *Chain) MissingHeights(ctx context.Context) (missing []int64, last int64, err error) {
+	row := chain.db.QueryRowContext(ctx, `SELECT coalesce(max(height), 0) FROM block WHERE fk_chain_id = ?`, chain.id)
+	if err := row.Scan(&last); err != nil {
+		return nil, 0, fmt.Errorf("query last height: %w", err)
+	}
+	if last == 0 {
+		return nil, 0, nil
+	}
+
+	rows, err := chain.db.QueryContext(ctx, `WITH RECURSIVE heights(height) AS (
+	SELECT 1 UNION ALL SELECT height + 1 FROM heights WHERE height < ?
+)
+SELECT height FROM heights
+WHERE height NOT IN (SELECT height FROM block WHERE fk_chain_id = ?)
+ORDER BY height ASC`, last, chain.id)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query missing heights: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var height int64
+		if err := rows.Scan(&height); err != nil {
+			return nil, 0, err
+		}
+		missing = append(missing, height)
+	}
+	return missing, last, rows.Err()
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
@@ -183,3 +183,34 @@ ORDER BY tendermint_event_attr.id`)
 		require.Zero(t, count)
 	})
 }
+
+func TestChain_MissingHeights(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := migratedDB()
+	defer db.Close()
+
+	chain := validChain(t, db)
+
+	missing, last, err := chain.MissingHeights(ctx)
+	require.NoError(t, err)
+	require.Empty(t, missing)
+	require.Zero(t, last)
+
+	for _, height := range []int64{1, 2, 4, 7} {
+		require.NoError(t, chain.SaveBlock(ctx, height, nil))
+	}
+	// Blocks of other chains are ignored.
+	tc, err := CreateTestCase(ctx, db, "OtherTestCase", "112233")
+	require.NoError(t, err)
+	other, err := tc.AddChain(ctx, "chain2", "cosmos")
+	require.NoError(t, err)
+	require.NoError(t, other.SaveBlock(ctx, 3, nil))
+	require.NoError(t, other.SaveBlock(ctx, 10, nil))
+
+	missing, last, err = chain.MissingHeights(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []int64{3, 5, 6}, missing)
+	require.EqualValues(t, 7, last)
+}
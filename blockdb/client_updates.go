@@ -0,0 +1,92 @@
+package blockdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// ClientUpdateResult is a light client update, found through the update_client event emitted for
+// each successful /ibc.core.client.v1.MsgUpdateClient.
+type ClientUpdateResult struct {
+	ChainPKey int64  // chain primary key
+	ChainID   string // E.g. osmosis-1001
+	Height    int64
+	// Time the block was saved, which trails the block time by the collector's poll rate.
+	CreatedAt time.Time
+	TxID      int64
+
+	ClientID string
+	// Signer of the MsgUpdateClient, typically a relayer wallet.
+	Signer sql.NullString
+	// HeaderSize is the size in bytes of the proto encoded header, or 0 if the chain does not emit it.
+	HeaderSize int
+
+	// Gas used and wanted by the whole tx, which may hold other messages besides the client update.
+	GasUsed, GasWanted int64
+}
+
+// ClientUpdates returns the light client updates of every chain of the test case,
+// ordered by chain ID and height.
+func (q *Query) ClientUpdates(ctx context.Context, testCaseID int64) ([]ClientUpdateResult, error) {
+	rows, err := q.db.QueryContext(ctx, `SELECT
+        chain.id
+        , chain.chain_id
+        , block.height
+        , block.created_at
+        , tx.id
+        , client_attr.value
+        , (
+            SELECT json_extract(msg.value, "$.signer") FROM json_each(COALESCE(gunzip(tx.data_gzip), tx.data), "$.body.messages") AS msg
+            WHERE json_extract(msg.value, "$.@type") = "/ibc.core.client.v1.MsgUpdateClient"
+              AND json_extract(msg.value, "$.client_id") = client_attr.value
+            LIMIT 1
+        ) AS signer
+        , COALESCE(length(header_attr.value) / 2, 0) -- header is hex encoded
+        , tx.gas_used
+        , tx.gas_wanted
+    FROM tendermint_event
+    INNER JOIN tendermint_event_attr AS client_attr
+        ON client_attr.fk_event_id = tendermint_event.id AND client_attr.key = "client_id"
+    LEFT JOIN tendermint_event_attr AS header_attr
+        ON header_attr.fk_event_id = tendermint_event.id AND header_attr.key = "header"
+    INNER JOIN tx ON tendermint_event.fk_tx_id = tx.id
+    INNER JOIN block ON tx.fk_block_id = block.id
+    INNER JOIN chain ON block.fk_chain_id = chain.id
+    WHERE tendermint_event.type = "update_client" AND chain.fk_test_id = ?
+    ORDER BY chain.chain_id ASC, block.height ASC, tendermint_event.id ASC`, testCaseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []ClientUpdateResult
+	for rows.Next() {
+		var (
+			res       ClientUpdateResult
+			createdAt string
+		)
+		if err := rows.Scan(
+			&res.ChainPKey,
+			&res.ChainID,
+			&res.Height,
+			&createdAt,
+			&res.TxID,
+			&res.ClientID,
+			&res.Signer,
+			&res.HeaderSize,
+			&res.GasUsed,
+			&res.GasWanted,
+		); err != nil {
+			return nil, err
+		}
+		t, err := timeToLocal(createdAt)
+		if err != nil {
+			return nil, fmt.Errorf("parse createdAt: %w", err)
+		}
+		res.CreatedAt = t
+		results = append(results, res)
+	}
+	return results, rows.Err()
+}
@@ -0,0 +1,109 @@
+package blockdb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func updateClientTx(signer string, clientIDs ...string) Tx {
+	var (
+		msgs   string
+		events []Event
+	)
+	for i, clientID := range clientIDs {
+		if i > 0 {
+			msgs += ","
+		}
+		msgs += `{"@type":"/ibc.core.client.v1.MsgUpdateClient","client_id":"` + clientID + `","signer":"` + signer + `"}`
+		events = append(events, Event{
+			Type: "update_client",
+			Attributes: []EventAttribute{
+				{Key: "client_id", Value: clientID},
+				{Key: "client_type", Value: "07-tendermint"},
+				{Key: "header", Value: "0a0b0c0d"},
+			},
+		})
+	}
+	return Tx{
+		Data:      []byte(`{"body":{"messages":[` + msgs + `]}}`),
+		Events:    events,
+		GasUsed:   90_000,
+		GasWanted: 120_000,
+	}
+}
+
+func TestQuery_ClientUpdates(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := migratedDB()
+	defer db.Close()
+
+	tc, err := CreateTestCase(ctx, db, "test", "sha")
+	require.NoError(t, err)
+	chainB, err := tc.AddChain(ctx, "chain-b", "cosmos")
+	require.NoError(t, err)
+	chainA, err := tc.AddChain(ctx, "chain-a", "cosmos")
+	require.NoError(t, err)
+
+	transfer := Tx{
+		Data:   []byte(`{"body":{"messages":[{"@type":"/ibc.applications.transfer.v1.MsgTransfer"}]}}`),
+		Events: []Event{{Type: "send_packet", Attributes: []EventAttribute{{Key: "packet_sequence", Value: "1"}}}},
+	}
+	require.NoError(t, chainB.SaveBlock(ctx, 3, []Tx{transfer, updateClientTx("relayer-b", "07-tendermint-0", "07-tendermint-1")}))
+	require.NoError(t, chainA.SaveBlock(ctx, 7, []Tx{updateClientTx("relayer-a", "07-tendermint-0")}))
+
+	// Updates of other test cases are excluded.
+	other, err := CreateTestCase(ctx, db, "other", "sha")
+	require.NoError(t, err)
+	otherChain, err := other.AddChain(ctx, "chain-a", "cosmos")
+	require.NoError(t, err)
+	require.NoError(t, otherChain.SaveBlock(ctx, 1, []Tx{updateClientTx("relayer-a", "07-tendermint-0")}))
+
+	results, err := NewQuery(db).ClientUpdates(ctx, tc.ID())
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	got := results[0]
+	require.Equal(t, "chain-a", got.ChainID)
+	require.EqualValues(t, 7, got.Height)
+	require.Equal(t, "07-tendermint-0", got.ClientID)
+	require.Equal(t, "relayer-a", got.Signer.String)
+	require.Equal(t, 4, got.HeaderSize)
+	require.EqualValues(t, 90_000, got.GasUsed)
+	require.EqualValues(t, 120_000, got.GasWanted)
+	require.WithinDuration(t, time.Now(), got.CreatedAt, 10*time.Second)
+
+	require.Equal(t, "chain-b", results[1].ChainID)
+	require.Equal(t, "07-tendermint-0", results[1].ClientID)
+	require.Equal(t, "07-tendermint-1", results[2].ClientID)
+	require.Equal(t, "relayer-b", results[2].Signer.String)
+	require.Equal(t, results[1].TxID, results[2].TxID)
+}
+
+func TestQuery_ClientUpdates_Compressed(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := migratedDB()
+	defer db.Close()
+
+	tc, err := CreateTestCase(ctx, db, "test", "sha")
+	require.NoError(t, err)
+	chain, err := tc.AddChain(ctx, "chain-a", "cosmos")
+	require.NoError(t, err)
+	require.NoError(t, chain.SaveBlock(ctx, 7, []Tx{updateClientTx("relayer-a", "07-tendermint-0")}))
+
+	compressed, err := CompressTxData(ctx, db, 0)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, compressed)
+
+	results, err := NewQuery(db).ClientUpdates(ctx, tc.ID())
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, "07-tendermint-0", results[0].ClientID)
+	require.Equal(t, "relayer-a", results[0].Signer.String)
+}
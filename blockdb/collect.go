@@ -2,8 +2,9 @@ package blockdb
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strings"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
@@ -13,9 +14,41 @@ type Tx struct {
 	// For Tendermint transactions, this should be encoded as JSON.
 	// Otherwise, this should be a human-readable format if possible.
 	Data []byte
+	// Raw is the transaction as included in the block, if Data was decoded from it.
+	Raw []byte
+	// DecodeError explains why Data is not, or only partly, decoded from Raw.
+	DecodeError string
 
 	// Events associated with the transaction, if applicable.
 	Events []Event
+
+	// Gas used and wanted by the transaction, if applicable.
+	GasUsed, GasWanted int64
+
+	// Result of executing the transaction, where a non-zero Code means the transaction failed.
+	Code      uint32
+	Codespace string
+	Log       string
+}
+
+// Block is a block with its transactions and block-level data.
+type Block struct {
+	Height int64
+	Header BlockHeader
+	Txs    []Tx
+
+	// Events emitted outside of transactions, e.g. by FinalizeBlock or BeginBlock and EndBlock.
+	// These hold most side effects such as IBC timeouts and governance proposals passing.
+	Events []Event
+}
+
+// BlockHeader holds the fields of a block header which explain how the chain progressed.
+// Hashes and addresses should be hex encoded.
+type BlockHeader struct {
+	Time            time.Time
+	ProposerAddress string
+	AppHash         string
+	ValidatorsHash  string
 }
 
 // Event is an alternative representation of tendermint/abci/types.Event,
@@ -44,18 +77,68 @@ type BlockSaver interface {
 	SaveBlock(ctx context.Context, height int64, txs []Tx) error
 }
 
-// Collector saves block transactions at regular intervals.
+// ErrFutureHeight is returned, possibly wrapped, by a TxFinder or BlockFinder for a height the chain has not reached yet.
+// The Collector retries the height without logging the error.
+var ErrFutureHeight = errors.New("height is greater than the current chain height")
+
+// BlockFinder finds a block at height with its block-level data.
+// If the TxFinder of a Collector implements BlockFinder, and its BlockSaver implements BlockDataSaver,
+// the Collector saves whole blocks instead of only their transactions.
+type BlockFinder interface {
+	FindBlock(ctx context.Context, height int64) (Block, error)
+}
+
+// BlockDataSaver saves a block with its block-level data.
+type BlockDataSaver interface {
+	SaveBlockData(ctx context.Context, block Block) error
+}
+
+// BlockNotifier is optionally implemented by a TxFinder, so that the Collector saves blocks as soon as
+// they are produced instead of waiting for its next poll.
+type BlockNotifier interface {
+	// NotifyNewBlocks sends the height of each new block, until ctx is done or the subscription
+	// fails, and then closes the channel.
+	NotifyNewBlocks(ctx context.Context) (<-chan int64, error)
+}
+
+// HeightTracker is optionally implemented by a BlockSaver, so that a Collector resumes from the heights
+// saved by a previous Collector.
+type HeightTracker interface {
+	// MissingHeights returns, in ascending order, the heights below the last saved height which
+	// were not saved, and the last saved height or 0 if none were saved.
+	MissingHeights(ctx context.Context) (missing []int64, last int64, err error)
+}
+
+// collectRangeAttempts is how many times in a row CollectRange tries to save a height, before giving up.
+const collectRangeAttempts = 10
+
+// notifiedPollFactor slows down polling while the Collector is notified of new blocks.
+// Polling continues as a fallback, in case notifications stop without the channel being closed.
+const notifiedPollFactor = 10
+
+// Collector saves block transactions as blocks are produced.
 type Collector struct {
 	finder TxFinder
 	log    *zap.Logger
 	rate   time.Duration
 	saver  BlockSaver
-	cancel context.CancelFunc
+
+	mu      sync.Mutex
+	cancel  context.CancelFunc
+	stopped bool
+
+	// failedHeight is the height which last failed to be saved, so its repeated failures are only logged once.
+	failedHeight int64
+	// failedAttempts counts the attempts in a row to save failedHeight.
+	failedAttempts int
 }
 
 // NewCollector creates a valid Collector that polls every duration at rate.
 // The rate should be less than the time it takes to produce a block.
 // Typically, a rate that will collect a few times a second is sufficient such as 100-200ms.
+//
+// If the finder implements BlockNotifier, the Collector subscribes to new blocks
+// and polls at a slower rate, resubscribing whenever the subscription ends.
 func NewCollector(log *zap.Logger, finder TxFinder, saver BlockSaver, rate time.Duration) *Collector {
 	return &Collector{
 		finder: finder,
@@ -66,43 +149,167 @@ func NewCollector(log *zap.Logger, finder TxFinder, saver BlockSaver, rate time.
 }
 
 // Collect saves block transactions starting at height 1 and advancing by 1 height as long as there are
-// no errors with finding or saving the transactions.
+// no errors with finding or saving the transactions. A height which fails is retried until it succeeds,
+// so that collection survives nodes restarting, e.g. for chain upgrades.
+//
+// If the saver implements HeightTracker, Collect first backfills the heights a previous Collector missed,
+// and then continues after the last saved height.
 func (p *Collector) Collect(ctx context.Context) {
-	ctx, p.cancel = context.WithCancel(ctx)
-	defer p.cancel()
+	ctx, cancel := p.start(ctx)
+	defer cancel()
+
+	_ = p.collect(ctx, p.backfill(ctx), 0)
+}
+
+// CollectRange is like Collect, but saves the heights from start to end, both included, and returns once end
+// is saved. Heights the chain has not reached yet are waited for, but a height which fails to be saved
+// for another reason is only retried a few times, after which CollectRange returns the error.
+// If Stop is called or ctx is done first, it returns the error of ctx.
+//
+// If end is 0, it keeps saving new blocks, retrying failed heights like Collect, until Stop is called or ctx is done,
+// and returns nil.
+// Heights missed by a previous Collector are not backfilled.
+func (p *Collector) CollectRange(ctx context.Context, start, end int64) error {
+	ctx, cancel := p.start(ctx)
+	defer cancel()
+
+	return p.collect(ctx, start, end)
+}
 
+// collect saves heights from height to end, or without end if end is 0.
+// With an end, it returns the error of a height which failed collectRangeAttempts times.
+func (p *Collector) collect(ctx context.Context, height, end int64) error {
 	tick := time.NewTicker(p.rate)
 	defer tick.Stop()
-	var height int64 = 1
+
+	notifier, canNotify := p.finder.(BlockNotifier)
+	var (
+		newBlocks      <-chan int64
+		lastSubscribed time.Time
+	)
 	for {
+		if canNotify && newBlocks == nil && time.Since(lastSubscribed) >= notifiedPollFactor*p.rate {
+			lastSubscribed = time.Now()
+			ch, err := notifier.NotifyNewBlocks(ctx)
+			if err != nil {
+				p.log.Debug("Failed to subscribe to new blocks, polling instead", zap.Error(err))
+			} else {
+				newBlocks = ch
+				tick.Reset(notifiedPollFactor * p.rate)
+			}
+		}
+
 		select {
 		case <-ctx.Done():
-			return
-		case <-tick.C:
-			if err := p.saveTxsForHeight(ctx, height); err != nil {
-				if strings.Contains(err.Error(), "must be less than or equal to the current blockchain height") {
-					// (I could not find a more precise way to match this error.)
-					// Don't log because it happens frequently and is expected.
-					continue
-				}
-
-				p.log.Info("Failed to save transactions", zap.Error(err), zap.Int64("height", height))
+			if end == 0 {
+				return nil
+			}
+			return ctx.Err()
+		case _, ok := <-newBlocks:
+			if !ok {
+				newBlocks = nil
+				tick.Reset(p.rate)
 				continue
 			}
-			height++
+		case <-tick.C:
+		}
+		var err error
+		height, err = p.catchUp(ctx, height, end)
+		switch {
+		case end == 0:
+		case height > end:
+			return nil
+		case err != nil && p.failedAttempts >= collectRangeAttempts:
+			return fmt.Errorf("height %d failed %d times: %w", height, p.failedAttempts, err)
 		}
 	}
 }
 
+// start returns the context of a Collect call, which is done once Stop is called, even if Stop was called first.
+func (p *Collector) start(ctx context.Context) (context.Context, context.CancelFunc) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	ctx, p.cancel = context.WithCancel(ctx)
+	if p.stopped {
+		p.cancel()
+	}
+	return ctx, p.cancel
+}
+
 // Stop terminates the Collect loop.
 // Stop is safe to be called concurrently and is safe to be called multiple times.
-//
-// If Collect has not been called, Stop panics.
+// If Collect has not been called yet, it returns as soon as it is called.
 func (p *Collector) Stop() {
-	p.cancel()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.stopped = true
+	if p.cancel != nil {
+		p.cancel()
+	}
+}
+
+// backfill saves the heights missed by a previous Collector and returns the height to continue from.
+// Missing heights which fail to be saved are logged and skipped.
+func (p *Collector) backfill(ctx context.Context) int64 {
+	tracker, ok := p.saver.(HeightTracker)
+	if !ok {
+		return 1
+	}
+	missing, last, err := tracker.MissingHeights(ctx)
+	if err != nil {
+		p.log.Info("Failed to find missing heights, collecting from height 1", zap.Error(err))
+		return 1
+	}
+	for _, height := range missing {
+		if err := p.saveTxsForHeight(ctx, height); err != nil {
+			p.log.Info("Failed to backfill block", zap.Error(err), zap.Int64("height", height))
+		}
+	}
+	return last + 1
 }
 
+// catchUp saves heights starting at height until the chain height or end, if not 0, is reached or saving fails,
+// and returns the next height to save, with the error if saving it failed for another reason than ErrFutureHeight.
+func (p *Collector) catchUp(ctx context.Context, height, end int64) (int64, error) {
+	for ctx.Err() == nil && (end == 0 || height <= end) {
+		err := p.saveTxsForHeight(ctx, height)
+		switch {
+		case err == nil:
+			if p.failedHeight == height {
+				p.log.Info("Resumed saving blocks", zap.Int64("height", height))
+				p.failedHeight, p.failedAttempts = 0, 0
+			}
+			height++
+		case errors.Is(err, ErrFutureHeight):
+			// Don't log because it happens frequently and is expected.
+			return height, nil
+		default:
+			if p.failedHeight != height {
+				p.log.Info("Failed to save transactions", zap.Error(err), zap.Int64("height", height))
+				p.failedHeight, p.failedAttempts = height, 0
+			} else {
+				p.log.Debug("Failed to save transactions", zap.Error(err), zap.Int64("height", height))
+			}
+			p.failedAttempts++
+			return height, err
+		}
+	}
+	return height, nil
+}
 func (p *Collector) saveTxsForHeight(ctx context.Context, height int64) error {
+	blockFinder, canFind := p.finder.(BlockFinder)
+	blockSaver, canSave := p.saver.(BlockDataSaver)
+	if canFind && canSave {
+		block, err := blockFinder.FindBlock(ctx, height)
+		if err != nil {
+			return fmt.Errorf("find block: %w", err)
+		}
+		if err := blockSaver.SaveBlockData(ctx, block); err != nil {
+			return fmt.Errorf("save block: %w", err)
+		}
+		return nil
+	}
+
 	txs, err := p.finder.FindTxs(ctx, height)
 	if err != nil {
 		return fmt.Errorf("find txs: %w", err)
@@ -3,6 +3,7 @@ package blockdb
 import (
 	"context"
 	"errors"
+	"fmt"
 	"runtime"
 	"strconv"
 	"sync"
@@ -128,6 +129,241 @@ func TestCollector_Collect(t *testing.T) {
 		require.Equal(t, 2, <-ch)
 		require.Equal(t, 2, <-ch) // assert height stops advancing
 	})
+
+	t.Run("block finder", func(t *testing.T) {
+		finder := mockBlockFinder(func(ctx context.Context, height int64) (Block, error) {
+			return Block{
+				Height: height,
+				Header: BlockHeader{AppHash: strconv.FormatInt(height, 10)},
+				Events: []Event{{Type: "finalize"}},
+			}, nil
+		})
+		ch := make(chan Block)
+		saver := mockBlockDataSaver(func(ctx context.Context, block Block) error {
+			select {
+			case <-ctx.Done():
+			case ch <- block:
+			}
+			return nil
+		})
+
+		collector := NewCollector(nopLog, finder, saver, time.Nanosecond)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			collector.Collect(context.Background())
+		}()
+
+		for height := int64(1); height <= 2; height++ {
+			got := <-ch
+			require.Equal(t, height, got.Height)
+			require.Equal(t, strconv.FormatInt(height, 10), got.Header.AppHash)
+			require.Equal(t, []Event{{Type: "finalize"}}, got.Events)
+		}
+
+		// Wait for Collect to return, so that its goroutine does not leak into TestCollector_Stop.
+		collector.Stop()
+		<-done
+	})
+
+	t.Run("notified of new blocks", func(t *testing.T) {
+		var chainHeight atomic.Int64
+		newBlocks := make(chan int64)
+		finder := &mockNotifier{
+			mockTxFinder: func(ctx context.Context, height int64) ([]Tx, error) {
+				if height > chainHeight.Load() {
+					return nil, fmt.Errorf("height %d: %w", height, ErrFutureHeight)
+				}
+				return nil, nil
+			},
+			newBlocks: newBlocks,
+		}
+		ch := make(chan int64)
+		saver := mockBlockSaver(func(ctx context.Context, height int64, txs []Tx) error {
+			select {
+			case <-ctx.Done():
+			case ch <- height:
+			}
+			return nil
+		})
+
+		// Polling must not be needed to collect notified blocks.
+		collector := NewCollector(nopLog, finder, saver, time.Hour)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			collector.Collect(context.Background())
+		}()
+
+		chainHeight.Store(2)
+		newBlocks <- 2
+		require.EqualValues(t, 1, <-ch)
+		require.EqualValues(t, 2, <-ch)
+
+		chainHeight.Store(3)
+		newBlocks <- 3
+		require.EqualValues(t, 3, <-ch)
+
+		collector.Stop()
+		<-done
+		require.EqualValues(t, 1, finder.subscriptions.Load())
+	})
+
+	t.Run("backfill", func(t *testing.T) {
+		finder := mockTxFinder(func(ctx context.Context, height int64) ([]Tx, error) {
+			if height > 5 {
+				return nil, ErrFutureHeight
+			}
+			return nil, nil
+		})
+		ch := make(chan int64)
+		saver := &mockHeightTracker{
+			mockBlockSaver: func(ctx context.Context, height int64, txs []Tx) error {
+				select {
+				case <-ctx.Done():
+				case ch <- height:
+				}
+				return nil
+			},
+			missing: []int64{2, 3},
+			last:    4,
+		}
+
+		collector := NewCollector(nopLog, finder, saver, time.Nanosecond)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			collector.Collect(context.Background())
+		}()
+
+		require.EqualValues(t, 2, <-ch)
+		require.EqualValues(t, 3, <-ch)
+		require.EqualValues(t, 5, <-ch)
+
+		collector.Stop()
+		<-done
+	})
+}
+
+func TestCollector_CollectRange(t *testing.T) {
+	finder := mockTxFinder(func(ctx context.Context, height int64) ([]Tx, error) {
+		if height > 10 {
+			return nil, ErrFutureHeight
+		}
+		return nil, nil
+	})
+	var saved []int64
+	saver := &mockHeightTracker{
+		mockBlockSaver: func(ctx context.Context, height int64, txs []Tx) error {
+			saved = append(saved, height)
+			return nil
+		},
+		missing: []int64{1},
+		last:    2,
+	}
+
+	collector := NewCollector(zap.NewNop(), finder, saver, time.Nanosecond)
+	require.NoError(t, collector.CollectRange(context.Background(), 4, 7))
+
+	require.Equal(t, []int64{4, 5, 6, 7}, saved)
+}
+
+func TestCollector_CollectRange_Errors(t *testing.T) {
+	ctx := context.Background()
+	saver := mockBlockSaver(func(ctx context.Context, height int64, txs []Tx) error { return nil })
+
+	t.Run("gives up on a failing height", func(t *testing.T) {
+		var attempts int
+		finder := mockTxFinder(func(ctx context.Context, height int64) ([]Tx, error) {
+			if height == 3 {
+				attempts++
+				return nil, errors.New("boom")
+			}
+			return nil, nil
+		})
+		err := NewCollector(zap.NewNop(), finder, saver, time.Nanosecond).CollectRange(ctx, 1, 5)
+		require.ErrorContains(t, err, "height 3 failed")
+		require.ErrorContains(t, err, "boom")
+		require.Equal(t, collectRangeAttempts, attempts)
+	})
+
+	t.Run("retries a height which recovers", func(t *testing.T) {
+		var attempts int
+		finder := mockTxFinder(func(ctx context.Context, height int64) ([]Tx, error) {
+			if height == 3 && attempts < collectRangeAttempts-1 {
+				attempts++
+				return nil, errors.New("boom")
+			}
+			return nil, nil
+		})
+		require.NoError(t, NewCollector(zap.NewNop(), finder, saver, time.Nanosecond).CollectRange(ctx, 1, 5))
+	})
+
+	t.Run("waits for future heights", func(t *testing.T) {
+		var calls int
+		finder := mockTxFinder(func(ctx context.Context, height int64) ([]Tx, error) {
+			if calls++; height > 2 && calls < 2*collectRangeAttempts {
+				return nil, ErrFutureHeight
+			}
+			return nil, nil
+		})
+		require.NoError(t, NewCollector(zap.NewNop(), finder, saver, time.Nanosecond).CollectRange(ctx, 1, 5))
+	})
+
+	t.Run("stopped", func(t *testing.T) {
+		finder := mockTxFinder(func(ctx context.Context, height int64) ([]Tx, error) {
+			return nil, ErrFutureHeight
+		})
+		collector := NewCollector(zap.NewNop(), finder, saver, time.Millisecond)
+		collector.Stop()
+		require.ErrorIs(t, collector.CollectRange(ctx, 1, 5), context.Canceled)
+
+		// Following only ends when stopped.
+		collector = NewCollector(zap.NewNop(), finder, saver, time.Millisecond)
+		collector.Stop()
+		require.NoError(t, collector.CollectRange(ctx, 1, 0))
+	})
+}
+
+type mockNotifier struct {
+	mockTxFinder
+	newBlocks     chan int64
+	subscriptions atomic.Int64
+}
+
+func (n *mockNotifier) NotifyNewBlocks(ctx context.Context) (<-chan int64, error) {
+	n.subscriptions.Add(1)
+	return n.newBlocks, nil
+}
+
+type mockHeightTracker struct {
+	mockBlockSaver
+	missing []int64
+	last    int64
+}
+
+func (tr *mockHeightTracker) MissingHeights(ctx context.Context) ([]int64, int64, error) {
+	return tr.missing, tr.last, nil
+}
+
+type mockBlockFinder func(ctx context.Context, height int64) (Block, error)
+
+func (f mockBlockFinder) FindBlock(ctx context.Context, height int64) (Block, error) {
+	return f(ctx, height)
+}
+
+func (f mockBlockFinder) FindTxs(ctx context.Context, height int64) ([]Tx, error) {
+	panic("FindTxs must not be called when FindBlock is available")
+}
+
+type mockBlockDataSaver func(ctx context.Context, block Block) error
+
+func (f mockBlockDataSaver) SaveBlockData(ctx context.Context, block Block) error {
+	return f(ctx, block)
+}
+
+func (f mockBlockDataSaver) SaveBlock(ctx context.Context, height int64, txs []Tx) error {
+	panic("SaveBlock must not be called when SaveBlockData is available")
 }
 
 func TestCollector_Stop(t *testing.T) {
@@ -173,3 +409,26 @@ func TestCollector_Stop(t *testing.T) {
 
 	require.Failf(t, "goroutine count did not drop after stopping collector", "want %d, got %d", n, runtime.NumGoroutine())
 }
+
+func TestCollector_StopBeforeCollect(t *testing.T) {
+	finder := mockTxFinder(func(ctx context.Context, height int64) ([]Tx, error) {
+		return nil, ErrFutureHeight
+	})
+	saver := mockBlockSaver(func(ctx context.Context, height int64, txs []Tx) error {
+		return nil
+	})
+
+	collector := NewCollector(zap.NewNop(), finder, saver, time.Millisecond)
+	collector.Stop()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		collector.Collect(context.Background())
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Collect did not return after Stop")
+	}
+}
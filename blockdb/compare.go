@@ -0,0 +1,289 @@
+package blockdb
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"sort"
+)
+
+// BlockSummary summarizes the content of a block, so that blocks of different test runs can be compared.
+type BlockSummary struct {
+	Height        int64
+	TxTotal       int64
+	FailedTxTotal int64 // Txs with a non-zero result code.
+	GasUsed       int64
+	GasWanted     int64
+
+	// MsgTypes are the cosmos message types of the txs, e.g. /ibc.core.client.v1.MsgUpdateClient,
+	// in the order of the txs and their messages.
+	MsgTypes []string
+	// Events counts the events of the txs and the block-level events by type.
+	Events map[string]int
+}
+
+// EventTotal is the number of events of the txs and of the block.
+func (s BlockSummary) EventTotal() int {
+	var total int
+	for _, n := range s.Events {
+		total += n
+	}
+	return total
+}
+
+// Differences between two block summaries, as returned by BlockComparison.Differences.
+const (
+	DiffMissing  = "missing"
+	DiffTxs      = "txs"
+	DiffFailures = "failures"
+	DiffMessages = "messages"
+	DiffEvents   = "events"
+	DiffGas      = "gas"
+)
+
+// BlockComparison aligns the block at the same height of the same chain in two test runs.
+type BlockComparison struct {
+	// ChainID is the chain ID in run A, or in run B if run A did not save the chain.
+	ChainID string
+	// ChainIDB is the chain ID in run B if it differs from ChainID, e.g. because of a different generated suffix.
+	ChainIDB string
+	Height   int64
+	// A and B summarize the block in each run, or are nil if the run did not save the block.
+	A, B *BlockSummary
+}
+
+// Differences returns which of DiffMissing, DiffTxs, DiffFailures, DiffMessages, DiffEvents and DiffGas
+// differ between the runs, or nil if the blocks look alike.
+func (c BlockComparison) Differences() []string {
+	if c.A == nil || c.B == nil {
+		return []string{DiffMissing}
+	}
+	var diffs []string
+	if c.A.TxTotal != c.B.TxTotal {
+		diffs = append(diffs, DiffTxs)
+	}
+	if c.A.FailedTxTotal != c.B.FailedTxTotal {
+		diffs = append(diffs, DiffFailures)
+	}
+	if !slices.Equal(c.A.MsgTypes, c.B.MsgTypes) {
+		diffs = append(diffs, DiffMessages)
+	}
+	if !maps.Equal(c.A.Events, c.B.Events) {
+		diffs = append(diffs, DiffEvents)
+	}
+	if c.A.GasUsed != c.B.GasUsed || c.A.GasWanted != c.B.GasWanted {
+		diffs = append(diffs, DiffGas)
+	}
+	return diffs
+}
+
+// CompareTestCases aligns the blocks of two test cases, typically two runs of the same test, by chain and height.
+// Chains are aligned by type and chain ID, ignoring a numeric suffix such as the one generated for chain specs
+// without a chain ID, so gaia-1 of one run aligns with gaia-7 of the other. Chains which only differ by suffix
+// within a test case are aligned in the order they were added.
+// Every height saved by either run is returned, ordered by chain ID and height.
+func (q *Query) CompareTestCases(ctx context.Context, testCaseA, testCaseB int64) ([]BlockComparison, error) {
+	a, err := q.blockSummaries(ctx, testCaseA)
+	if err != nil {
+		return nil, fmt.Errorf("summarize test case %d: %w", testCaseA, err)
+	}
+	b, err := q.blockSummaries(ctx, testCaseB)
+	if err != nil {
+		return nil, fmt.Errorf("summarize test case %d: %w", testCaseB, err)
+	}
+
+	type blockKey struct {
+		chain  chainKey
+		height int64
+	}
+	byKey := make(map[blockKey]*BlockComparison)
+	for _, run := range []struct {
+		summaries *testCaseSummaries
+		isA       bool
+	}{{a, true}, {b, false}} {
+		for chain, blocks := range run.summaries.blocks {
+			for height, summary := range blocks {
+				k := blockKey{chain, height}
+				c, ok := byKey[k]
+				if !ok {
+					c = &BlockComparison{Height: height}
+					c.ChainID, c.ChainIDB = a.chainIDs[chain], b.chainIDs[chain]
+					if c.ChainID == "" {
+						c.ChainID = c.ChainIDB
+					}
+					if c.ChainIDB == c.ChainID {
+						c.ChainIDB = ""
+					}
+					byKey[k] = c
+				}
+				if run.isA {
+					c.A = summary
+				} else {
+					c.B = summary
+				}
+			}
+		}
+	}
+
+	results := make([]BlockComparison, 0, len(byKey))
+	for _, c := range byKey {
+		results = append(results, *c)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].ChainID != results[j].ChainID {
+			return results[i].ChainID < results[j].ChainID
+		}
+		return results[i].Height < results[j].Height
+	})
+	return results, nil
+}
+
+// chainIDSuffix matches the numeric suffix of a chain ID, such as the one generated for chain specs.
+var chainIDSuffix = regexp.MustCompile(`-\d+$`)
+
+// chainKey identifies a chain across test cases.
+type chainKey struct {
+	chainType string
+	name      string // The chain ID without chainIDSuffix.
+	n         int    // The order among the chains of the test case with the same type and name.
+}
+
+// testCaseSummaries are the block summaries of a test case.
+type testCaseSummaries struct {
+	chainIDs map[chainKey]string
+	blocks   map[chainKey]map[int64]*BlockSummary
+}
+
+// blockSummaries summarizes the blocks of a test case, keyed by chain and height.
+func (q *Query) blockSummaries(ctx context.Context, testCaseID int64) (*testCaseSummaries, error) {
+	summaries := &testCaseSummaries{
+		chainIDs: make(map[chainKey]string),
+		blocks:   make(map[chainKey]map[int64]*BlockSummary),
+	}
+	chains, err := q.chainKeys(ctx, testCaseID, summaries.chainIDs)
+	if err != nil {
+		return nil, err
+	}
+	get := func(chain, height int64) *BlockSummary {
+		key := chains[chain]
+		blocks, ok := summaries.blocks[key]
+		if !ok {
+			blocks = make(map[int64]*BlockSummary)
+			summaries.blocks[key] = blocks
+		}
+		s, ok := blocks[height]
+		if !ok {
+			s = &BlockSummary{Height: height, Events: make(map[string]int)}
+			blocks[height] = s
+		}
+		return s
+	}
+
+	rows, err := q.db.QueryContext(ctx, `SELECT
+        chain.id
+        , block.height
+        , (SELECT COUNT(*) FROM tx WHERE tx.fk_block_id = block.id)
+        , (SELECT COUNT(*) FROM tx WHERE tx.fk_block_id = block.id AND tx.code != 0)
+        , (SELECT COALESCE(SUM(tx.gas_used), 0) FROM tx WHERE tx.fk_block_id = block.id)
+        , (SELECT COALESCE(SUM(tx.gas_wanted), 0) FROM tx WHERE tx.fk_block_id = block.id)
+    FROM block
+    INNER JOIN chain ON block.fk_chain_id = chain.id
+    WHERE chain.fk_test_id = ?`, testCaseID)
+	if err != nil {
+		return nil, fmt.Errorf("query blocks: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var chain, height, txs, failed, used, wanted int64
+		if err := rows.Scan(&chain, &height, &txs, &failed, &used, &wanted); err != nil {
+			return nil, err
+		}
+		s := get(chain, height)
+		s.TxTotal, s.FailedTxTotal, s.GasUsed, s.GasWanted = txs, failed, used, wanted
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Txs of chains other than cosmos may not be JSON, and txs may be compressed by CompressTxData.
+	rows, err = q.db.QueryContext(ctx, `WITH tx_data AS (
+        SELECT tx.id, tx.fk_block_id, COALESCE(gunzip(tx.data_gzip), tx.data) AS data FROM tx
+    )
+    SELECT chain.id, block.height, COALESCE(json_extract(msg.value, "$.@type"), "")
+    FROM tx_data AS tx
+    INNER JOIN block ON tx.fk_block_id = block.id
+    INNER JOIN chain ON block.fk_chain_id = chain.id
+    , json_each(CASE WHEN json_valid(tx.data) THEN tx.data ELSE "{}" END, "$.body.messages") AS msg
+    WHERE chain.fk_test_id = ?
+    ORDER BY tx.id ASC, msg.key ASC`, testCaseID)
+	if err != nil {
+		return nil, fmt.Errorf("query message types: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			chain, height int64
+			msgType       string
+		)
+		if err := rows.Scan(&chain, &height, &msgType); err != nil {
+			return nil, err
+		}
+		s := get(chain, height)
+		s.MsgTypes = append(s.MsgTypes, msgType)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err = q.db.QueryContext(ctx, `SELECT chain.id, block.height, tendermint_event.type, COUNT(*)
+    FROM tendermint_event
+    LEFT JOIN tx ON tendermint_event.fk_tx_id = tx.id
+    INNER JOIN block ON block.id = COALESCE(tx.fk_block_id, tendermint_event.fk_block_id)
+    INNER JOIN chain ON block.fk_chain_id = chain.id
+    WHERE chain.fk_test_id = ?
+    GROUP BY chain.id, block.height, tendermint_event.type`, testCaseID)
+	if err != nil {
+		return nil, fmt.Errorf("query events: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			chain, height int64
+			eventType     string
+			count         int
+		)
+		if err := rows.Scan(&chain, &height, &eventType, &count); err != nil {
+			return nil, err
+		}
+		get(chain, height).Events[eventType] = count
+	}
+	return summaries, rows.Err()
+}
+
+// chainKeys returns the keys of the chains of a test case by their row ID, recording their chain IDs in chainIDs.
+func (q *Query) chainKeys(ctx context.Context, testCaseID int64, chainIDs map[chainKey]string) (map[int64]chainKey, error) {
+	rows, err := q.db.QueryContext(ctx, `SELECT id, chain_id, chain_type FROM chain WHERE fk_test_id = ? ORDER BY id`, testCaseID)
+	if err != nil {
+		return nil, fmt.Errorf("query chains: %w", err)
+	}
+	defer rows.Close()
+	keys := make(map[int64]chainKey)
+	for rows.Next() {
+		var (
+			id                 int64
+			chainID, chainType string
+		)
+		if err := rows.Scan(&id, &chainID, &chainType); err != nil {
+			return nil, err
+		}
+		key := chainKey{chainType: chainType, name: chainIDSuffix.ReplaceAllString(chainID, "")}
+		for _, exists := chainIDs[key]; exists; _, exists = chainIDs[key] {
+			key.n++
+		}
+		keys[id] = key
+		chainIDs[key] = chainID
+	}
+	return keys, rows.Err()
+}
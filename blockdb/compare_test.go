@@ -0,0 +1,132 @@
+package blockdb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuery_CompareTestCases(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := migratedDB()
+	defer db.Close()
+
+	msgTx := func(code uint32, gas int64, msgTypes ...string) Tx {
+		var msgs string
+		for i, typ := range msgTypes {
+			if i > 0 {
+				msgs += ","
+			}
+			msgs += `{"@type":"` + typ + `"}`
+		}
+		return Tx{
+			Data:    []byte(`{"body":{"messages":[` + msgs + `]}}`),
+			Events:  []Event{{Type: "message"}},
+			Code:    code,
+			GasUsed: gas,
+		}
+	}
+	const transfer, update = "/ibc.applications.transfer.v1.MsgTransfer", "/ibc.core.client.v1.MsgUpdateClient"
+
+	mainRun, err := CreateTestCase(ctx, db, "TestIBC", "main")
+	require.NoError(t, err)
+	chainA, err := mainRun.AddChain(ctx, "chain-a", "cosmos")
+	require.NoError(t, err)
+	require.NoError(t, chainA.SaveBlock(ctx, 1, []Tx{msgTx(0, 100, transfer)}))
+	require.NoError(t, chainA.SaveBlock(ctx, 2, []Tx{msgTx(0, 100, update, transfer)}))
+	require.NoError(t, chainA.SaveBlockData(ctx, Block{Height: 3, Events: []Event{{Type: "timeout_packet"}}}))
+	require.NoError(t, chainA.SaveBlock(ctx, 4, []Tx{{Data: []byte("not json")}}))
+
+	// Runs of the same test differ by name here, because test cases with the same name must be created in different seconds.
+	branchRun, err := CreateTestCase(ctx, db, "TestIBC/branch", "branch")
+	require.NoError(t, err)
+	chainA, err = branchRun.AddChain(ctx, "chain-a", "cosmos")
+	require.NoError(t, err)
+	require.NoError(t, chainA.SaveBlock(ctx, 1, []Tx{msgTx(0, 100, transfer)}))
+	require.NoError(t, chainA.SaveBlock(ctx, 2, []Tx{msgTx(5, 120, update), msgTx(0, 100, transfer)}))
+	require.NoError(t, chainA.SaveBlock(ctx, 3, nil))
+	require.NoError(t, chainA.SaveBlock(ctx, 4, []Tx{{Data: []byte("not json")}}))
+	chainB, err := branchRun.AddChain(ctx, "chain-b", "cosmos")
+	require.NoError(t, err)
+	require.NoError(t, chainB.SaveBlock(ctx, 1, nil))
+
+	results, err := NewQuery(db).CompareTestCases(ctx, mainRun.ID(), branchRun.ID())
+	require.NoError(t, err)
+	require.Len(t, results, 5)
+
+	same := results[0]
+	require.Equal(t, "chain-a", same.ChainID)
+	require.EqualValues(t, 1, same.Height)
+	require.Equal(t, []string{transfer}, same.A.MsgTypes)
+	require.Empty(t, same.Differences())
+
+	failed := results[1]
+	require.EqualValues(t, 2, failed.Height)
+	require.EqualValues(t, 1, failed.A.TxTotal)
+	require.EqualValues(t, 2, failed.B.TxTotal)
+	require.EqualValues(t, 1, failed.B.FailedTxTotal)
+	require.Equal(t, []string{update, transfer}, failed.B.MsgTypes)
+	require.Equal(t, 2, failed.B.EventTotal())
+	require.Equal(t, []string{DiffTxs, DiffFailures, DiffEvents, DiffGas}, failed.Differences())
+
+	timeout := results[2]
+	require.Equal(t, map[string]int{"timeout_packet": 1}, timeout.A.Events)
+	require.Equal(t, []string{DiffEvents}, timeout.Differences())
+
+	notJSON := results[3]
+	require.Empty(t, notJSON.A.MsgTypes)
+	require.Empty(t, notJSON.Differences())
+
+	missing := results[4]
+	require.Equal(t, "chain-b", missing.ChainID)
+	require.Nil(t, missing.A)
+	require.NotNil(t, missing.B)
+	require.Equal(t, []string{DiffMissing}, missing.Differences())
+}
+
+func TestQuery_CompareTestCases_ChainIDSuffix(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := migratedDB()
+	defer db.Close()
+
+	// Chain IDs generated for chain specs get a suffix counting the chains of the process.
+	saveRun := func(name string, chains ...string) int64 {
+		tc, err := CreateTestCase(ctx, db, name, "abc123")
+		require.NoError(t, err)
+		for i, chainID := range chains {
+			chain, err := tc.AddChain(ctx, chainID, "cosmos")
+			require.NoError(t, err)
+			txs := make([]Tx, i+1)
+			for j := range txs {
+				txs[j] = Tx{Data: []byte(`{}`)}
+			}
+			require.NoError(t, chain.SaveBlock(ctx, 1, txs))
+		}
+		return tc.ID()
+	}
+	runA := saveRun("TestIBC", "gaia-1", "gaia-2", "osmosis-3")
+	runB := saveRun("TestIBC/rerun", "gaia-7", "gaia-8", "osmosis-9", "juno-10")
+
+	results, err := NewQuery(db).CompareTestCases(ctx, runA, runB)
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+
+	for i, want := range []struct{ chainID, chainIDB string }{
+		{"gaia-1", "gaia-7"},
+		{"gaia-2", "gaia-8"},
+		{"juno-10", ""},
+		{"osmosis-3", "osmosis-9"},
+	} {
+		require.Equal(t, want.chainID, results[i].ChainID)
+		require.Equal(t, want.chainIDB, results[i].ChainIDB)
+	}
+	require.Empty(t, results[0].Differences())
+	require.Empty(t, results[1].Differences())
+	require.Equal(t, []string{DiffMissing}, results[2].Differences())
+	require.Empty(t, results[3].Differences())
+}
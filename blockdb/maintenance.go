@@ -0,0 +1,173 @@
+package blockdb
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/strangelove-ventures/interchaintest/v8/testutil"
+	"modernc.org/sqlite"
+)
+
+// compressedTxData replaces the data of a compressed tx. It is JSON, so that views parsing tx data skip the tx.
+const compressedTxData = `{"compressed":"data_gzip"}`
+
+func init() {
+	// gunzip decompresses tx data in queries, e.g. SELECT COALESCE(gunzip(data_gzip), data) FROM tx.
+	sqlite.MustRegisterDeterministicScalarFunction("gunzip", 1, func(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		switch v := args[0].(type) {
+		case nil:
+			return nil, nil
+		case []byte:
+			b, err := testutil.GunzipIt(v)
+			if err != nil {
+				return nil, fmt.Errorf("gunzip: %w", err)
+			}
+			return string(b), nil
+		default:
+			return nil, fmt.Errorf("gunzip: argument must be a blob, got %T", v)
+		}
+	})
+}
+
+// PruneOptions selects the test cases deleted by PruneTestCases. Zero values select nothing.
+type PruneOptions struct {
+	// OlderThan deletes the test cases created more than OlderThan ago.
+	OlderThan time.Duration
+	// KeepRuns deletes all but the latest KeepRuns test cases of each test name.
+	KeepRuns int
+}
+
+// PruneTestCases deletes test cases along with their chains, blocks, txs and events, and returns how many
+// test cases were deleted. The file does not shrink until Vacuum.
+func PruneTestCases(ctx context.Context, db *sql.DB, opts PruneOptions) (int64, error) {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	// Deletes cascade only if foreign keys are enforced, which is a setting of the connection.
+	if _, err := conn.ExecContext(ctx, `PRAGMA foreign_keys = ON`); err != nil {
+		return 0, fmt.Errorf("pragma foreign_keys: %w", err)
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var deleted int64
+	if opts.OlderThan > 0 {
+		cutoff := time.Now().Add(-opts.OlderThan).UTC().Format(time.RFC3339)
+		res, err := tx.ExecContext(ctx, `DELETE FROM test_case WHERE created_at < ?`, cutoff)
+		if err != nil {
+			return 0, fmt.Errorf("delete test cases older than %s: %w", opts.OlderThan, err)
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return 0, err
+		}
+		deleted += n
+	}
+	if opts.KeepRuns > 0 {
+		res, err := tx.ExecContext(ctx, `DELETE FROM test_case WHERE id IN (
+        SELECT id FROM (
+            SELECT id, ROW_NUMBER() OVER (PARTITION BY name ORDER BY created_at DESC, id DESC) AS run FROM test_case
+        ) WHERE run > ?
+    )`, opts.KeepRuns)
+		if err != nil {
+			return 0, fmt.Errorf("delete test cases beyond the latest %d runs: %w", opts.KeepRuns, err)
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return 0, err
+		}
+		deleted += n
+	}
+	return deleted, tx.Commit()
+}
+
+// compressBatchSize bounds the tx data held in memory while compressing.
+const compressBatchSize = 500
+
+// CompressTxData gzips the data of the txs of test cases created more than olderThan ago, or of all test cases
+// if olderThan is zero, and returns how many txs were compressed. Queries and views, such as v_tx_flattened,
+// decompress the data with the gunzip function, so they return it as before.
+// The file does not shrink until Vacuum.
+func CompressTxData(ctx context.Context, db *sql.DB, olderThan time.Duration) (int64, error) {
+	cutoff := time.Now().Add(-olderThan).UTC().Format(time.RFC3339)
+	var compressed int64
+	for {
+		n, err := compressTxBatch(ctx, db, cutoff)
+		if err != nil {
+			return compressed, err
+		}
+		compressed += n
+		if n < compressBatchSize {
+			return compressed, nil
+		}
+	}
+}
+
+func compressTxBatch(ctx context.Context, db *sql.DB, cutoff string) (int64, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	rows, err := tx.QueryContext(ctx, `SELECT tx.id, tx.data FROM tx
+    INNER JOIN block ON tx.fk_block_id = block.id
+    INNER JOIN chain ON block.fk_chain_id = chain.id
+    INNER JOIN test_case ON chain.fk_test_id = test_case.id
+    WHERE tx.data_gzip IS NULL AND test_case.created_at <= ?
+    LIMIT ?`, cutoff, compressBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("query txs: %w", err)
+	}
+	type txData struct {
+		id   int64
+		data []byte
+	}
+	var batch []txData
+	for rows.Next() {
+		var d txData
+		if err := rows.Scan(&d.id, &d.data); err != nil {
+			_ = rows.Close()
+			return 0, err
+		}
+		batch = append(batch, d)
+	}
+	// Close before updating, as the rows hold the only connection.
+	if err := errors.Join(rows.Err(), rows.Close()); err != nil {
+		return 0, err
+	}
+
+	for _, d := range batch {
+		gz, err := testutil.GzipIt(d.data)
+		if err != nil {
+			return 0, fmt.Errorf("compress tx %d: %w", d.id, err)
+		}
+		if _, err := tx.ExecContext(ctx, `UPDATE tx SET data = ?, data_gzip = ? WHERE id = ?`, compressedTxData, gz, d.id); err != nil {
+			return 0, fmt.Errorf("update tx %d: %w", d.id, err)
+		}
+	}
+	return int64(len(batch)), tx.Commit()
+}
+
+// Vacuum rebuilds the database file to release the space of deleted and compressed data,
+// and truncates the write-ahead log.
+func Vacuum(ctx context.Context, db *sql.DB) error {
+	if _, err := db.ExecContext(ctx, `VACUUM`); err != nil {
+		return fmt.Errorf("vacuum: %w", err)
+	}
+	if _, err := db.ExecContext(ctx, `PRAGMA wal_checkpoint(TRUNCATE)`); err != nil {
+		return fmt.Errorf("checkpoint wal: %w", err)
+	}
+	return nil
+}
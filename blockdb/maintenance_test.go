@@ -0,0 +1,139 @@
+package blockdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruneTestCases(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := migratedDB()
+	defer db.Close()
+
+	createdAgo := func(name string, ago time.Duration) int64 {
+		tc, err := CreateTestCase(ctx, db, name, "abc123")
+		require.NoError(t, err)
+		_, err = db.Exec(`UPDATE test_case SET created_at = ? WHERE id = ?`, time.Now().Add(-ago).UTC().Format(time.RFC3339), tc.ID())
+		require.NoError(t, err)
+		chain, err := tc.AddChain(ctx, "chain-a", "cosmos")
+		require.NoError(t, err)
+		require.NoError(t, chain.SaveBlockData(ctx, Block{
+			Height: 1,
+			Txs:    []Tx{{Data: []byte(`{}`), Events: []Event{{Type: "message", Attributes: []EventAttribute{{Key: "action", Value: "send"}}}}}},
+			Events: []Event{{Type: "active_proposal"}},
+		}))
+		return tc.ID()
+	}
+	const day = 24 * time.Hour
+	createdAgo("TestA", 10*day)
+	createdAgo("TestB", 10*day)
+	createdAgo("TestA", 5*day)
+	latestA := createdAgo("TestA", time.Hour)
+	latestB := createdAgo("TestB", time.Hour)
+
+	count := func(table string) int {
+		var n int
+		require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM `+table).Scan(&n))
+		return n
+	}
+
+	deleted, err := PruneTestCases(ctx, db, PruneOptions{OlderThan: 7 * day})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, deleted)
+
+	deleted, err = PruneTestCases(ctx, db, PruneOptions{KeepRuns: 1})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, deleted)
+
+	var ids []int64
+	rows, err := db.Query(`SELECT id FROM test_case ORDER BY id`)
+	require.NoError(t, err)
+	for rows.Next() {
+		var id int64
+		require.NoError(t, rows.Scan(&id))
+		ids = append(ids, id)
+	}
+	require.NoError(t, rows.Err())
+	require.Equal(t, []int64{latestA, latestB}, ids)
+
+	// Children of the deleted test cases are deleted too.
+	require.Equal(t, 2, count("chain"))
+	require.Equal(t, 2, count("block"))
+	require.Equal(t, 2, count("tx"))
+	require.Equal(t, 4, count("tendermint_event"))
+	require.Equal(t, 2, count("tendermint_event_attr"))
+
+	deleted, err = PruneTestCases(ctx, db, PruneOptions{})
+	require.NoError(t, err)
+	require.Zero(t, deleted)
+
+	require.NoError(t, Vacuum(ctx, db))
+}
+
+func TestCompressTxData(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := migratedDB()
+	defer db.Close()
+
+	tc, err := CreateTestCase(ctx, db, "TestCompress", "abc123")
+	require.NoError(t, err)
+	chain, err := tc.AddChain(ctx, "chain-a", "cosmos")
+	require.NoError(t, err)
+	const data = `{"body":{"messages":[{"@type":"/cosmos.bank.v1beta1.MsgSend"}]}}`
+	txs := make([]Tx, compressBatchSize+1)
+	for i := range txs {
+		txs[i] = Tx{Data: []byte(data)}
+	}
+	require.NoError(t, chain.SaveBlock(ctx, 1, txs))
+	require.NoError(t, chain.SaveBlock(ctx, 2, []Tx{{Data: []byte(`not json`)}}))
+
+	// The test case is too recent.
+	compressed, err := CompressTxData(ctx, db, time.Hour)
+	require.NoError(t, err)
+	require.Zero(t, compressed)
+
+	compressed, err = CompressTxData(ctx, db, 0)
+	require.NoError(t, err)
+	require.EqualValues(t, len(txs)+1, compressed)
+
+	var gz []byte
+	require.NoError(t, db.QueryRow(`SELECT data_gzip FROM tx WHERE data = ? LIMIT 1`, compressedTxData).Scan(&gz))
+	require.NotEmpty(t, gz)
+
+	q := NewQuery(db)
+	results, err := q.Transactions(ctx, chain.id)
+	require.NoError(t, err)
+	require.Len(t, results, len(txs)+1)
+	require.Equal(t, data, string(results[0].Tx))
+	require.Equal(t, "not json", string(results[len(txs)].Tx))
+
+	// Views parsing tx data decompress it, and skip txs which are not JSON instead of failing.
+	msgs, err := q.CosmosMessages(ctx, chain.id)
+	require.NoError(t, err)
+	require.Len(t, msgs, len(txs))
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", msgs[0].Type)
+
+	var flattened string
+	require.NoError(t, db.QueryRow(`SELECT tx FROM v_tx_flattened ORDER BY tx_id LIMIT 1`).Scan(&flattened))
+	require.Equal(t, data, flattened)
+
+	comparisons, err := q.CompareTestCases(ctx, tc.ID(), tc.ID())
+	require.NoError(t, err)
+	require.Len(t, comparisons[0].A.MsgTypes, len(txs))
+
+	var decompressed sql.NullString
+	require.NoError(t, db.QueryRow(`SELECT gunzip(data_gzip) FROM tx LIMIT 1`).Scan(&decompressed))
+	require.Equal(t, data, decompressed.String)
+
+	compressed, err = CompressTxData(ctx, db, 0)
+	require.NoError(t, err)
+	require.Zero(t, compressed)
+}
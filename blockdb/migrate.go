@@ -148,6 +148,83 @@ ON CONFLICT(git_sha) DO UPDATE SET git_sha=git_sha`, nowRFC3339(), gitSha)
 		return fmt.Errorf("create table tendermint_event: %w", err)
 	}
 
+	_, err = tx.Exec(`ALTER TABLE tx ADD COLUMN gas_used INTEGER NOT NULL DEFAULT 0`)
+	if errIgnoreDuplicateColumn(err, "gas_used") != nil {
+		return fmt.Errorf("alter table tx add gas_used: %w", err)
+	}
+	_, err = tx.Exec(`ALTER TABLE tx ADD COLUMN gas_wanted INTEGER NOT NULL DEFAULT 0`)
+	if errIgnoreDuplicateColumn(err, "gas_wanted") != nil {
+		return fmt.Errorf("alter table tx add gas_wanted: %w", err)
+	}
+
+	for _, col := range []string{"block_time", "proposer_address", "app_hash", "validators_hash"} {
+		_, err = tx.Exec(fmt.Sprintf(`ALTER TABLE block ADD COLUMN %s TEXT`, col))
+		if errIgnoreDuplicateColumn(err, col) != nil {
+			return fmt.Errorf("alter table block add %s: %w", col, err)
+		}
+	}
+
+	_, err = tx.Exec(`ALTER TABLE tx ADD COLUMN code INTEGER NOT NULL DEFAULT 0`)
+	if errIgnoreDuplicateColumn(err, "code") != nil {
+		return fmt.Errorf("alter table tx add code: %w", err)
+	}
+	_, err = tx.Exec(`ALTER TABLE tx ADD COLUMN codespace TEXT NOT NULL DEFAULT ""`)
+	if errIgnoreDuplicateColumn(err, "codespace") != nil {
+		return fmt.Errorf("alter table tx add codespace: %w", err)
+	}
+	_, err = tx.Exec(`ALTER TABLE tx ADD COLUMN log TEXT NOT NULL DEFAULT ""`)
+	if errIgnoreDuplicateColumn(err, "log") != nil {
+		return fmt.Errorf("alter table tx add log: %w", err)
+	}
+
+	// Block-level events, such as those of FinalizeBlock, belong to a block instead of a tx.
+	_, err = tx.Exec(`ALTER TABLE tendermint_event ADD COLUMN fk_block_id INTEGER REFERENCES block(id) ON DELETE CASCADE`)
+	if errIgnoreDuplicateColumn(err, "fk_block_id") != nil {
+		return fmt.Errorf("alter table tendermint_event add fk_block_id: %w", err)
+	}
+
+	_, err = tx.Exec(`CREATE TABLE IF NOT EXISTS saved_query (
+    id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL CHECK (length(name) > 0),
+    query TEXT NOT NULL CHECK (length(query) > 0),
+    created_at TEXT NOT NULL CHECK (length(created_at) > 0),
+    UNIQUE(name)
+)`)
+	if err != nil {
+		return fmt.Errorf("create table saved_query: %w", err)
+	}
+
+	// Tx data compressed by CompressTxData, in which case data is only a placeholder.
+	_, err = tx.Exec(`ALTER TABLE tx ADD COLUMN data_gzip BLOB`)
+	if errIgnoreDuplicateColumn(err, "data_gzip") != nil {
+		return fmt.Errorf("alter table tx add data_gzip: %w", err)
+	}
+
+	// The tx as included in the block, and why it could not be decoded, if so.
+	_, err = tx.Exec(`ALTER TABLE tx ADD COLUMN raw BLOB`)
+	if errIgnoreDuplicateColumn(err, "raw") != nil {
+		return fmt.Errorf("alter table tx add raw: %w", err)
+	}
+	_, err = tx.Exec(`ALTER TABLE tx ADD COLUMN decode_error TEXT NOT NULL DEFAULT ""`)
+	if errIgnoreDuplicateColumn(err, "decode_error") != nil {
+		return fmt.Errorf("alter table tx add decode_error: %w", err)
+	}
+
+	// Without indexes on the foreign keys, deleting a test case scans its child tables for every deleted row.
+	for _, idx := range []struct{ table, column string }{
+		{"chain", "fk_test_id"},
+		{"block", "fk_chain_id"},
+		{"tx", "fk_block_id"},
+		{"tendermint_event", "fk_tx_id"},
+		{"tendermint_event", "fk_block_id"},
+		{"tendermint_event_attr", "fk_event_id"},
+	} {
+		_, err = tx.Exec(fmt.Sprintf(`CREATE INDEX IF NOT EXISTS idx_%[1]s_%[2]s ON %[1]s(%[2]s)`, idx.table, idx.column))
+		if err != nil {
+			return fmt.Errorf("create index on %s(%s): %w", idx.table, idx.column, err)
+		}
+	}
+
 	// Creating views should be last migration step.
 	if err := upsertViews(tx); err != nil {
 		// Error already wrapped.
@@ -183,8 +260,13 @@ SELECT
   , block.id as block_id
   , block.created_at as block_created_at
   , block.height as block_height
+  , block.block_time as block_time
   , tx.id as tx_id
-  , tx.data as tx
+  , COALESCE(gunzip(tx.data_gzip), tx.data) as tx -- decompressed if compressed by CompressTxData
+  , tx.code as tx_code
+  , tx.gas_used as tx_gas_used
+  , tx.gas_wanted as tx_gas_wanted
+  , tx.decode_error as tx_decode_error
 FROM tx
 LEFT JOIN block ON tx.fk_block_id = block.id
 LEFT JOIN chain ON block.fk_chain_id = chain.id
@@ -237,7 +319,9 @@ SELECT
       json_extract(value, "$.packet.destination_channel")       -- MsgRecvPacket and MsgAcknowledgement (might be backwards)
     ) as counterparty_channel_id
   , value as raw
-FROM v_tx_flattened, json_each(v_tx_flattened.tx, "$.body.messages")
+FROM v_tx_flattened
+  -- Txs of chains other than cosmos, or which failed to decode, may not be JSON.
+  , json_each(CASE WHEN json_valid(v_tx_flattened.tx) THEN v_tx_flattened.tx ELSE "{}" END, "$.body.messages")
 `)
 	if err != nil {
 		return fmt.Errorf("create v_cosmos_messages view: %w", err)
@@ -269,6 +353,159 @@ FROM v_tx_flattened, json_each(v_tx_flattened.tx, "$.body.messages")
 		return fmt.Errorf("create v_tx_agg view: %w", err)
 	}
 
+	// IBC packet lifecycle events of every chain, with the packet attributes as columns.
+	// Events belong to a tx, or to a block for those emitted outside of txs.
+	_, err = tx.Exec(`DROP VIEW IF EXISTS v_ibc_packet_events`)
+	if err != nil {
+		return fmt.Errorf("drop old v_ibc_packet_events view: %w", err)
+	}
+	_, err = tx.Exec(`CREATE VIEW v_ibc_packet_events AS
+SELECT
+  chain.fk_test_id AS test_case_id
+  , chain.id AS chain_kid
+  , chain.chain_id AS chain_id
+  , block.height AS block_height
+  , COALESCE(block.block_time, block.created_at) AS block_time
+  , tendermint_event.fk_tx_id AS tx_id
+  , tendermint_event.id AS event_id
+  , tendermint_event.type AS type
+  , CAST(MAX(CASE WHEN attr.key = "packet_sequence" THEN attr.value END) AS INTEGER) AS sequence
+  , MAX(CASE WHEN attr.key = "packet_src_port" THEN attr.value END) AS src_port
+  , MAX(CASE WHEN attr.key = "packet_src_channel" THEN attr.value END) AS src_channel
+  , MAX(CASE WHEN attr.key = "packet_dst_port" THEN attr.value END) AS dst_port
+  , MAX(CASE WHEN attr.key = "packet_dst_channel" THEN attr.value END) AS dst_channel
+  , MAX(CASE WHEN attr.key = "packet_ack" THEN attr.value END) AS ack
+  , MAX(CASE WHEN attr.key = "packet_connection" THEN attr.value END) AS connection
+FROM tendermint_event
+INNER JOIN tendermint_event_attr AS attr ON attr.fk_event_id = tendermint_event.id
+LEFT JOIN tx ON tendermint_event.fk_tx_id = tx.id
+INNER JOIN block ON block.id = COALESCE(tx.fk_block_id, tendermint_event.fk_block_id)
+INNER JOIN chain ON block.fk_chain_id = chain.id
+WHERE tendermint_event.type IN ("send_packet", "recv_packet", "write_acknowledgement", "acknowledge_packet", "timeout_packet")
+GROUP BY tendermint_event.id
+`)
+	if err != nil {
+		return fmt.Errorf("create v_ibc_packet_events view: %w", err)
+	}
+
+	// IBC connections of every chain, with the chain ID their client tracks.
+	// The client of a connection is found through its handshake events, and the chain ID through the
+	// MsgCreateClient of the create_client event for the client, which is matched by its msg_index attribute if present.
+	// The chain ID is NULL if the client was not created while the blocks were saved.
+	_, err = tx.Exec(`DROP VIEW IF EXISTS v_ibc_connections`)
+	if err != nil {
+		return fmt.Errorf("drop old v_ibc_connections view: %w", err)
+	}
+	_, err = tx.Exec(`CREATE VIEW v_ibc_connections AS
+WITH client_events AS (
+  SELECT
+    chain.fk_test_id AS test_case_id
+    , chain.id AS chain_kid
+    , tendermint_event.fk_tx_id AS tx_id
+    , tendermint_event.type AS type
+    , MAX(CASE WHEN attr.key = "connection_id" THEN attr.value END) AS connection_id
+    , MAX(CASE WHEN attr.key = "client_id" THEN attr.value END) AS client_id
+    , CAST(MAX(CASE WHEN attr.key = "msg_index" THEN attr.value END) AS INTEGER) AS msg_index
+  FROM tendermint_event
+  INNER JOIN tendermint_event_attr AS attr ON attr.fk_event_id = tendermint_event.id
+  INNER JOIN tx ON tendermint_event.fk_tx_id = tx.id
+  INNER JOIN block ON block.id = tx.fk_block_id
+  INNER JOIN chain ON block.fk_chain_id = chain.id
+  WHERE tendermint_event.type IN ("create_client", "connection_open_init", "connection_open_try", "connection_open_ack", "connection_open_confirm")
+  GROUP BY tendermint_event.id
+), client_chains AS (
+  SELECT created.chain_kid, created.client_id, MAX(msg.client_chain_id) AS counterparty_chain_id
+  FROM client_events AS created
+  INNER JOIN v_cosmos_messages AS msg ON msg.tx_id = created.tx_id
+    AND msg.type = "/ibc.core.client.v1.MsgCreateClient"
+    AND (created.msg_index IS NULL OR msg.msg_n = created.msg_index)
+  WHERE created.type = "create_client"
+  GROUP BY created.chain_kid, created.client_id
+  HAVING COUNT(DISTINCT msg.client_chain_id) = 1
+)
+SELECT
+  conn.test_case_id
+  , conn.chain_kid
+  , conn.connection_id
+  , conn.client_id
+  , client_chains.counterparty_chain_id
+FROM client_events AS conn
+LEFT JOIN client_chains ON client_chains.chain_kid = conn.chain_kid AND client_chains.client_id = conn.client_id
+WHERE conn.type != "create_client" AND conn.connection_id != ""
+GROUP BY conn.test_case_id, conn.chain_kid, conn.connection_id, conn.client_id
+`)
+	if err != nil {
+		return fmt.Errorf("create v_ibc_connections view: %w", err)
+	}
+
+	// One row per sent packet, with the first of each later lifecycle event joined from any chain of the test case.
+	// Packets are keyed by their source and destination port and channel and their sequence,
+	// because the same channel IDs are typically used in both directions.
+	// The receiving side of a packet is on the chain tracked by the client of the connection the packet was sent over.
+	// If that chain is unknown (see v_ibc_connections), the receiving side is only known to be on another chain,
+	// so the receipts of chains using the same channel IDs are all joined, e.g. of two separate pairs of chains.
+	_, err = tx.Exec(`DROP VIEW IF EXISTS v_ibc_packets`)
+	if err != nil {
+		return fmt.Errorf("drop old v_ibc_packets view: %w", err)
+	}
+	_, err = tx.Exec(`CREATE VIEW v_ibc_packets AS
+WITH first_events AS (
+  SELECT test_case_id, chain_kid, type, src_port, src_channel, dst_port, dst_channel, sequence, MIN(event_id) AS event_id
+  FROM v_ibc_packet_events
+  GROUP BY test_case_id, chain_kid, type, src_port, src_channel, dst_port, dst_channel, sequence
+), packet_events AS (
+  SELECT first_events.*, e.chain_id, e.block_height, e.block_time, e.ack, e.connection
+  FROM first_events
+  INNER JOIN v_ibc_packet_events AS e ON e.event_id = first_events.event_id
+)
+SELECT
+  send.test_case_id
+  , send.src_port
+  , send.src_channel
+  , send.dst_port
+  , send.dst_channel
+  , send.sequence
+  , send.chain_kid AS src_chain_kid
+  , send.chain_id AS src_chain_id
+  , send.block_height AS send_height
+  , send.block_time AS send_time
+  , recv.chain_kid AS dst_chain_kid
+  , recv.chain_id AS dst_chain_id
+  , recv.block_height AS recv_height
+  , recv.block_time AS recv_time
+  , write_ack.block_height AS write_ack_height
+  , write_ack.block_time AS write_ack_time
+  , write_ack.ack AS ack
+  , ack.block_height AS ack_height
+  , ack.block_time AS ack_time
+  , timeout.block_height AS timeout_height
+  , timeout.block_time AS timeout_time
+FROM packet_events AS send
+LEFT JOIN v_ibc_connections AS send_conn ON send_conn.chain_kid = send.chain_kid AND send_conn.connection_id = send.connection
+LEFT JOIN packet_events AS recv ON recv.type = "recv_packet"
+  AND recv.test_case_id = send.test_case_id AND recv.chain_kid != send.chain_kid
+  AND (send_conn.counterparty_chain_id IS NULL OR recv.chain_id = send_conn.counterparty_chain_id)
+  AND recv.src_port = send.src_port AND recv.src_channel = send.src_channel
+  AND recv.dst_port = send.dst_port AND recv.dst_channel = send.dst_channel AND recv.sequence = send.sequence
+LEFT JOIN packet_events AS write_ack ON write_ack.type = "write_acknowledgement"
+  AND write_ack.test_case_id = send.test_case_id AND write_ack.chain_kid != send.chain_kid
+  AND (send_conn.counterparty_chain_id IS NULL OR write_ack.chain_id = send_conn.counterparty_chain_id)
+  AND write_ack.src_port = send.src_port AND write_ack.src_channel = send.src_channel
+  AND write_ack.dst_port = send.dst_port AND write_ack.dst_channel = send.dst_channel AND write_ack.sequence = send.sequence
+LEFT JOIN packet_events AS ack ON ack.type = "acknowledge_packet"
+  AND ack.chain_kid = send.chain_kid
+  AND ack.src_port = send.src_port AND ack.src_channel = send.src_channel
+  AND ack.dst_port = send.dst_port AND ack.dst_channel = send.dst_channel AND ack.sequence = send.sequence
+LEFT JOIN packet_events AS timeout ON timeout.type = "timeout_packet"
+  AND timeout.chain_kid = send.chain_kid
+  AND timeout.src_port = send.src_port AND timeout.src_channel = send.src_channel
+  AND timeout.dst_port = send.dst_port AND timeout.dst_channel = send.dst_channel AND timeout.sequence = send.sequence
+WHERE send.type = "send_packet"
+`)
+	if err != nil {
+		return fmt.Errorf("create v_ibc_packets view: %w", err)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,152 @@
+package blockdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// PacketEvent is the block in which an IBC packet lifecycle event was emitted.
+type PacketEvent struct {
+	Height int64
+	// Block time, or the time the block was saved if the chain did not report it.
+	Time time.Time
+}
+
+// PacketResult is an IBC packet followed across the chains of a test case, from its send_packet event.
+// The lifecycle events which were not (yet) emitted are nil.
+type PacketResult struct {
+	SrcChainID string
+	// DstChainID is empty until the packet is received.
+	DstChainID string
+
+	SrcPort, SrcChannel string
+	DstPort, DstChannel string
+	Sequence            int64
+
+	Send     PacketEvent
+	Recv     *PacketEvent
+	WriteAck *PacketEvent
+	Ack      *PacketEvent
+	Timeout  *PacketEvent
+
+	// Acknowledgement written by the destination chain, if any.
+	Acknowledgement sql.NullString
+}
+
+// Stalled returns the type of the lifecycle event the packet is waiting for,
+// or an empty string if the packet was acknowledged or timed out.
+func (p PacketResult) Stalled() string {
+	switch {
+	case p.Ack != nil, p.Timeout != nil:
+		return ""
+	case p.Recv == nil:
+		return "recv_packet"
+	case p.WriteAck == nil:
+		// Async acknowledgements are written after the packet is received.
+		return "write_acknowledgement"
+	default:
+		return "acknowledge_packet"
+	}
+}
+
+// RecvDuration is the time it took to relay the packet to the destination chain, or 0 if it was not received.
+func (p PacketResult) RecvDuration() time.Duration {
+	if p.Recv == nil {
+		return 0
+	}
+	return p.Recv.Time.Sub(p.Send.Time)
+}
+
+// AckDuration is the time it took to relay the acknowledgement back to the source chain
+// after it was written, or 0 if the packet was not acknowledged.
+func (p PacketResult) AckDuration() time.Duration {
+	if p.Ack == nil || p.WriteAck == nil {
+		return 0
+	}
+	return p.Ack.Time.Sub(p.WriteAck.Time)
+}
+
+// TotalDuration is the time from sending the packet until it was acknowledged or timed out,
+// or 0 if it is still in flight.
+func (p PacketResult) TotalDuration() time.Duration {
+	switch {
+	case p.Ack != nil:
+		return p.Ack.Time.Sub(p.Send.Time)
+	case p.Timeout != nil:
+		return p.Timeout.Time.Sub(p.Send.Time)
+	default:
+		return 0
+	}
+}
+
+// Packets returns the IBC packets sent by any chain of the test case, with the lifecycle events
+// emitted for them on every chain. Results are ordered by send time, source chain and sequence.
+func (q *Query) Packets(ctx context.Context, testCaseID int64) ([]PacketResult, error) {
+	rows, err := q.db.QueryContext(ctx, `SELECT
+        src_chain_id, dst_chain_id
+        , src_port, src_channel, dst_port, dst_channel, sequence
+        , send_height, send_time
+        , recv_height, recv_time
+        , write_ack_height, write_ack_time
+        , ack_height, ack_time
+        , timeout_height, timeout_time
+        , ack
+    FROM v_ibc_packets
+    WHERE test_case_id = ?
+    ORDER BY send_time ASC, src_chain_id ASC, src_channel ASC, sequence ASC`, testCaseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []PacketResult
+	for rows.Next() {
+		var (
+			res        PacketResult
+			dstChainID sql.NullString
+			sendTime   string
+
+			recvHeight, writeAckHeight, ackHeight, timeoutHeight sql.NullInt64
+			recvTime, writeAckTime, ackTime, timeoutTime         sql.NullString
+		)
+		if err := rows.Scan(
+			&res.SrcChainID, &dstChainID,
+			&res.SrcPort, &res.SrcChannel, &res.DstPort, &res.DstChannel, &res.Sequence,
+			&res.Send.Height, &sendTime,
+			&recvHeight, &recvTime,
+			&writeAckHeight, &writeAckTime,
+			&ackHeight, &ackTime,
+			&timeoutHeight, &timeoutTime,
+			&res.Acknowledgement,
+		); err != nil {
+			return nil, err
+		}
+		res.DstChainID = dstChainID.String
+		if res.Send.Time, err = timeToLocal(sendTime); err != nil {
+			return nil, fmt.Errorf("parse send time: %w", err)
+		}
+		for _, e := range []struct {
+			dst    **PacketEvent
+			height sql.NullInt64
+			time   sql.NullString
+		}{
+			{&res.Recv, recvHeight, recvTime},
+			{&res.WriteAck, writeAckHeight, writeAckTime},
+			{&res.Ack, ackHeight, ackTime},
+			{&res.Timeout, timeoutHeight, timeoutTime},
+		} {
+			if !e.height.Valid {
+				continue
+			}
+			t, err := timeToLocal(e.time.String)
+			if err != nil {
+				return nil, fmt.Errorf("parse packet event time: %w", err)
+			}
+			*e.dst = &PacketEvent{Height: e.height.Int64, Time: t}
+		}
+		results = append(results, res)
+	}
+	return results, rows.Err()
+}
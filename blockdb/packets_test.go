@@ -0,0 +1,189 @@
+package blockdb
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func packetEvent(typ string, seq int64, srcChannel, dstChannel string, extra ...EventAttribute) Event {
+	return Event{
+		Type: typ,
+		Attributes: append([]EventAttribute{
+			{Key: "packet_sequence", Value: strconv.FormatInt(seq, 10)},
+			{Key: "packet_src_port", Value: "transfer"},
+			{Key: "packet_src_channel", Value: srcChannel},
+			{Key: "packet_dst_port", Value: "transfer"},
+			{Key: "packet_dst_channel", Value: dstChannel},
+		}, extra...),
+	}
+}
+
+func TestQuery_Packets(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := migratedDB()
+	defer db.Close()
+
+	tc, err := CreateTestCase(ctx, db, "test", "sha")
+	require.NoError(t, err)
+	chainA, err := tc.AddChain(ctx, "chain-a", "cosmos")
+	require.NoError(t, err)
+	chainB, err := tc.AddChain(ctx, "chain-b", "cosmos")
+	require.NoError(t, err)
+
+	start := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	save := func(chain *Chain, height int64, events ...Event) {
+		t.Helper()
+		require.NoError(t, chain.SaveBlockData(ctx, Block{
+			Height: height,
+			Header: BlockHeader{Time: start.Add(time.Duration(height) * time.Second)},
+			Txs:    []Tx{{Data: []byte(`{}`), Events: events}},
+		}))
+	}
+
+	// Both directions use channel-0, so packets are told apart by the chain they are sent from.
+	save(chainA, 1,
+		packetEvent("send_packet", 1, "channel-0", "channel-0"),
+		packetEvent("send_packet", 2, "channel-0", "channel-0"),
+		packetEvent("send_packet", 3, "channel-0", "channel-0"),
+	)
+	save(chainB, 2, packetEvent("send_packet", 1, "channel-0", "channel-0"))
+	save(chainB, 4,
+		packetEvent("recv_packet", 1, "channel-0", "channel-0"),
+		packetEvent("write_acknowledgement", 1, "channel-0", "channel-0", EventAttribute{Key: "packet_ack", Value: `{"result":"AQ=="}`}),
+	)
+	save(chainA, 5, packetEvent("recv_packet", 1, "channel-0", "channel-0"))
+	save(chainA, 9, packetEvent("acknowledge_packet", 1, "channel-0", "channel-0"))
+	// Timeouts of packets which were never received.
+	require.NoError(t, chainA.SaveBlockData(ctx, Block{
+		Height: 20,
+		Header: BlockHeader{Time: start.Add(20 * time.Second)},
+		Events: []Event{packetEvent("timeout_packet", 2, "channel-0", "channel-0")},
+	}))
+
+	// Packets of other test cases are excluded.
+	other, err := CreateTestCase(ctx, db, "other", "sha")
+	require.NoError(t, err)
+	otherChain, err := other.AddChain(ctx, "chain-a", "cosmos")
+	require.NoError(t, err)
+	save(otherChain, 1, packetEvent("send_packet", 1, "channel-0", "channel-0"))
+
+	packets, err := NewQuery(db).Packets(ctx, tc.ID())
+	require.NoError(t, err)
+	require.Len(t, packets, 4)
+
+	acked := packets[0]
+	require.Equal(t, "chain-a", acked.SrcChainID)
+	require.Equal(t, "chain-b", acked.DstChainID)
+	require.Equal(t, "transfer", acked.SrcPort)
+	require.Equal(t, "channel-0", acked.DstChannel)
+	require.EqualValues(t, 1, acked.Sequence)
+	require.EqualValues(t, 1, acked.Send.Height)
+	require.EqualValues(t, 4, acked.Recv.Height)
+	require.EqualValues(t, 4, acked.WriteAck.Height)
+	require.EqualValues(t, 9, acked.Ack.Height)
+	require.Nil(t, acked.Timeout)
+	require.Equal(t, `{"result":"AQ=="}`, acked.Acknowledgement.String)
+	require.Empty(t, acked.Stalled())
+	require.Equal(t, 3*time.Second, acked.RecvDuration())
+	require.Equal(t, 5*time.Second, acked.AckDuration())
+	require.Equal(t, 8*time.Second, acked.TotalDuration())
+
+	timedOut := packets[1]
+	require.EqualValues(t, 2, timedOut.Sequence)
+	require.Empty(t, timedOut.DstChainID)
+	require.Nil(t, timedOut.Recv)
+	require.EqualValues(t, 20, timedOut.Timeout.Height)
+	require.Empty(t, timedOut.Stalled())
+	require.Equal(t, 19*time.Second, timedOut.TotalDuration())
+
+	inFlight := packets[2]
+	require.EqualValues(t, 3, inFlight.Sequence)
+	require.Equal(t, "recv_packet", inFlight.Stalled())
+	require.Zero(t, inFlight.TotalDuration())
+
+	fromB := packets[3]
+	require.Equal(t, "chain-b", fromB.SrcChainID)
+	require.Equal(t, "chain-a", fromB.DstChainID)
+	require.EqualValues(t, 5, fromB.Recv.Height)
+	require.Nil(t, fromB.WriteAck)
+	require.Equal(t, "write_acknowledgement", fromB.Stalled())
+}
+
+func TestQuery_Packets_CounterpartyChain(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := migratedDB()
+	defer db.Close()
+
+	tc, err := CreateTestCase(ctx, db, "test", "sha")
+	require.NoError(t, err)
+
+	// Two separate pairs of chains, each with the same client, connection and channel IDs.
+	chains := make(map[string]*Chain)
+	for _, pair := range [][2]string{{"chain-a", "chain-b"}, {"chain-c", "chain-d"}} {
+		for i, id := range pair {
+			chain, err := tc.AddChain(ctx, id, "cosmos")
+			require.NoError(t, err)
+			chains[id] = chain
+
+			counterparty := pair[1-i]
+			require.NoError(t, chain.SaveBlockData(ctx, Block{Height: 1, Txs: []Tx{
+				{
+					Data: []byte(`{"body":{"messages":[
+						{"@type":"/cosmos.bank.v1beta1.MsgSend"},
+						{"@type":"/ibc.core.client.v1.MsgCreateClient","client_state":{"chain_id":"` + counterparty + `"}}
+					]}}`),
+					Events: []Event{{Type: "create_client", Attributes: []EventAttribute{
+						{Key: "client_id", Value: "07-tendermint-0"},
+						{Key: "msg_index", Value: "1"},
+					}}},
+				},
+				{
+					Data: []byte(`{}`),
+					Events: []Event{{Type: "connection_open_init", Attributes: []EventAttribute{
+						{Key: "connection_id", Value: "connection-0"},
+						{Key: "client_id", Value: "07-tendermint-0"},
+						{Key: "counterparty_client_id", Value: "07-tendermint-0"},
+						{Key: "counterparty_connection_id", Value: ""},
+					}}},
+				},
+			}}))
+		}
+	}
+
+	rows, err := db.QueryContext(ctx, `SELECT counterparty_chain_id FROM v_ibc_connections ORDER BY chain_kid`)
+	require.NoError(t, err)
+	var counterparties []string
+	for rows.Next() {
+		var id string
+		require.NoError(t, rows.Scan(&id))
+		counterparties = append(counterparties, id)
+	}
+	require.NoError(t, rows.Close())
+	require.Equal(t, []string{"chain-b", "chain-a", "chain-d", "chain-c"}, counterparties)
+
+	connection := EventAttribute{Key: "packet_connection", Value: "connection-0"}
+	for height, ids := range map[int64][2]string{2: {"chain-a", "chain-b"}, 3: {"chain-c", "chain-d"}} {
+		require.NoError(t, chains[ids[0]].SaveBlockData(ctx, Block{Height: height, Txs: []Tx{{
+			Data: []byte(`{}`), Events: []Event{packetEvent("send_packet", 1, "channel-0", "channel-0", connection)},
+		}}}))
+		require.NoError(t, chains[ids[1]].SaveBlockData(ctx, Block{Height: height, Txs: []Tx{{
+			Data: []byte(`{}`), Events: []Event{packetEvent("recv_packet", 1, "channel-0", "channel-0", connection)},
+		}}}))
+	}
+
+	packets, err := NewQuery(db).Packets(ctx, tc.ID())
+	require.NoError(t, err)
+	require.Len(t, packets, 2)
+	for _, p := range packets {
+		want := map[string]string{"chain-a": "chain-b", "chain-c": "chain-d"}[p.SrcChainID]
+		require.Equal(t, want, p.DstChainID)
+	}
+}
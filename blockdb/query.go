@@ -172,16 +172,33 @@ func (q *Query) CosmosMessages(ctx context.Context, chainPkey int64) ([]CosmosMe
 type TxResult struct {
 	Height int64
 	Tx     []byte
+	// Raw is the tx as included in the block, if Tx was decoded from it.
+	Raw []byte
+	// DecodeError explains why Tx is not, or only partly, decoded from Raw.
+	DecodeError string
+
+	// Result of executing the tx, where a non-zero Code means the tx failed.
+	Code      uint32
+	Codespace string
+	Log       string
+
+	GasUsed, GasWanted int64
 }
 
 // Transactions returns TxResults only for blocks with transactions present.
 // chainPkey is the chain primary key "chain.id", not to be confused with the column "chain_id".
 func (q *Query) Transactions(ctx context.Context, chainPkey int64) ([]TxResult, error) {
-	rows, err := q.db.QueryContext(ctx, `SELECT block.height, tx.data FROM tx 
+	return q.TransactionsAfter(ctx, chainPkey, -1)
+}
+
+// TransactionsAfter is like Transactions, but only returns the txs of blocks above height,
+// e.g. to follow the txs of a running chain.
+func (q *Query) TransactionsAfter(ctx context.Context, chainPkey int64, height int64) ([]TxResult, error) {
+	rows, err := q.db.QueryContext(ctx, `SELECT block.height, COALESCE(gunzip(tx.data_gzip), tx.data), tx.raw, tx.decode_error, tx.code, tx.codespace, tx.log, tx.gas_used, tx.gas_wanted FROM tx 
     INNER JOIN block on tx.fk_block_id = block.id
     INNER JOIN chain on block.fk_chain_id = chain.id
-    WHERE chain.id = ?
-    ORDER BY block.height ASC, tx.id ASC`, chainPkey)
+    WHERE chain.id = ? AND block.height > ?
+    ORDER BY block.height ASC, tx.id ASC`, chainPkey, height)
 	if err != nil {
 		return nil, err
 	}
@@ -190,7 +207,7 @@ func (q *Query) Transactions(ctx context.Context, chainPkey int64) ([]TxResult,
 	var results []TxResult
 	for rows.Next() {
 		var res TxResult
-		if err := rows.Scan(&res.Height, &res.Tx); err != nil {
+		if err := rows.Scan(&res.Height, &res.Tx, &res.Raw, &res.DecodeError, &res.Code, &res.Codespace, &res.Log, &res.GasUsed, &res.GasWanted); err != nil {
 			return nil, err
 		}
 		results = append(results, res)
@@ -198,3 +215,119 @@ func (q *Query) Transactions(ctx context.Context, chainPkey int64) ([]TxResult,
 
 	return results, nil
 }
+
+// BlockResult is a block with its header fields and a summary of its transactions.
+type BlockResult struct {
+	Height int64
+	// Block time in the user's local time zone, if known.
+	Time            sql.NullTime
+	ProposerAddress sql.NullString
+	AppHash         sql.NullString
+	ValidatorsHash  sql.NullString
+
+	TxTotal       int64
+	FailedTxTotal int64 // Txs with a non-zero result code.
+	GasUsed       int64
+	GasWanted     int64
+
+	// Block-level events, e.g. those of FinalizeBlock.
+	EventTotal int64
+}
+
+// Blocks returns the blocks of a chain with a summary of their transactions, ordered by height.
+// chainPkey is the chain primary key "chain.id", not to be confused with the column "chain_id".
+func (q *Query) Blocks(ctx context.Context, chainPkey int64) ([]BlockResult, error) {
+	return q.BlocksAfter(ctx, chainPkey, -1)
+}
+
+// BlocksAfter is like Blocks, but only returns the blocks above height, e.g. to follow a running chain.
+func (q *Query) BlocksAfter(ctx context.Context, chainPkey int64, height int64) ([]BlockResult, error) {
+	rows, err := q.db.QueryContext(ctx, `SELECT
+        block.height
+        , block.block_time
+        , block.proposer_address
+        , block.app_hash
+        , block.validators_hash
+        , (SELECT COUNT(*) FROM tx WHERE tx.fk_block_id = block.id)
+        , (SELECT COUNT(*) FROM tx WHERE tx.fk_block_id = block.id AND tx.code != 0)
+        , (SELECT COALESCE(SUM(tx.gas_used), 0) FROM tx WHERE tx.fk_block_id = block.id)
+        , (SELECT COALESCE(SUM(tx.gas_wanted), 0) FROM tx WHERE tx.fk_block_id = block.id)
+        , (SELECT COUNT(*) FROM tendermint_event WHERE tendermint_event.fk_block_id = block.id)
+    FROM block
+    WHERE block.fk_chain_id = ? AND block.height > ?
+    ORDER BY block.height ASC`, chainPkey, height)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []BlockResult
+	for rows.Next() {
+		var (
+			res       BlockResult
+			blockTime sql.NullString
+		)
+		if err := rows.Scan(
+			&res.Height,
+			&blockTime,
+			&res.ProposerAddress,
+			&res.AppHash,
+			&res.ValidatorsHash,
+			&res.TxTotal,
+			&res.FailedTxTotal,
+			&res.GasUsed,
+			&res.GasWanted,
+			&res.EventTotal,
+		); err != nil {
+			return nil, err
+		}
+		if blockTime.Valid {
+			t, err := timeToLocal(blockTime.String)
+			if err != nil {
+				return nil, fmt.Errorf("parse block time: %w", err)
+			}
+			res.Time = sql.NullTime{Time: t, Valid: true}
+		}
+		results = append(results, res)
+	}
+	return results, rows.Err()
+}
+
+// BlockEvents returns the block-level events of the block at height, in the order they were emitted.
+// chainPkey is the chain primary key "chain.id", not to be confused with the column "chain_id".
+func (q *Query) BlockEvents(ctx context.Context, chainPkey int64, height int64) ([]Event, error) {
+	rows, err := q.db.QueryContext(ctx, `SELECT tendermint_event.id, tendermint_event.type, tendermint_event_attr.key, tendermint_event_attr.value
+    FROM tendermint_event
+    INNER JOIN block ON tendermint_event.fk_block_id = block.id
+    LEFT JOIN tendermint_event_attr ON tendermint_event_attr.fk_event_id = tendermint_event.id
+    WHERE block.fk_chain_id = ? AND block.height = ?
+    ORDER BY tendermint_event.id ASC, tendermint_event_attr.id ASC`, chainPkey, height)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		results []Event
+		lastID  int64 = -1
+	)
+	for rows.Next() {
+		var (
+			id         int64
+			typ        string
+			key, value sql.NullString
+		)
+		if err := rows.Scan(&id, &typ, &key, &value); err != nil {
+			return nil, err
+		}
+		if id != lastID {
+			results = append(results, Event{Type: typ})
+			lastID = id
+		}
+		if key.Valid {
+			e := &results[len(results)-1]
+			e.Attributes = append(e.Attributes, EventAttribute{Key: key.String, Value: value.String})
+		}
+	}
+	return results, rows.Err()
+}
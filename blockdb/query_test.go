@@ -199,6 +199,11 @@ func TestQuery_Transactions(t *testing.T) {
 
 		require.EqualValues(t, 14, results[2].Height)
 		require.Equal(t, "3", string(results[2].Tx))
+
+		results, err = NewQuery(db).TransactionsAfter(ctx, chain.id, 12)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		require.EqualValues(t, 14, results[0].Height)
 	})
 
 	t.Run("no txs", func(t *testing.T) {
@@ -216,3 +221,92 @@ func TestQuery_Transactions(t *testing.T) {
 		require.Len(t, results, 0)
 	})
 }
+
+func TestQuery_Blocks(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := migratedDB()
+	defer db.Close()
+
+	tc, err := CreateTestCase(ctx, db, "test", "abc123")
+	require.NoError(t, err)
+	chain, err := tc.AddChain(ctx, "chain-a", "cosmos")
+	require.NoError(t, err)
+
+	blockTime := time.Date(2024, 5, 1, 12, 0, 0, 500, time.UTC)
+	require.NoError(t, chain.SaveBlockData(ctx, Block{
+		Height: 5,
+		Header: BlockHeader{
+			Time:            blockTime,
+			ProposerAddress: "AB12",
+			AppHash:         "CD34",
+			ValidatorsHash:  "EF56",
+		},
+		Txs: []Tx{
+			{Data: []byte(`ok`), GasUsed: 100, GasWanted: 200},
+			{Data: []byte(`failed`), GasUsed: 50, GasWanted: 200, Code: 5, Codespace: "sdk", Log: "insufficient funds",
+				Raw: []byte{0x0a, 0x01}, DecodeError: "unable to resolve type URL /foo.MsgBar"},
+		},
+		Events: []Event{
+			{Type: "timeout_packet", Attributes: []EventAttribute{{Key: "packet_sequence", Value: "1"}, {Key: "packet_src_channel", Value: "channel-0"}}},
+			{Type: "active_proposal"},
+		},
+	}))
+	// Saved without block-level data.
+	require.NoError(t, chain.SaveBlock(ctx, 6, nil))
+
+	q := NewQuery(db)
+	blocks, err := q.Blocks(ctx, chain.id)
+	require.NoError(t, err)
+	require.Len(t, blocks, 2)
+
+	got := blocks[0]
+	require.EqualValues(t, 5, got.Height)
+	require.True(t, got.Time.Valid)
+	require.True(t, blockTime.Equal(got.Time.Time))
+	require.Equal(t, "AB12", got.ProposerAddress.String)
+	require.Equal(t, "CD34", got.AppHash.String)
+	require.Equal(t, "EF56", got.ValidatorsHash.String)
+	require.EqualValues(t, 2, got.TxTotal)
+	require.EqualValues(t, 1, got.FailedTxTotal)
+	require.EqualValues(t, 150, got.GasUsed)
+	require.EqualValues(t, 400, got.GasWanted)
+	require.EqualValues(t, 2, got.EventTotal)
+
+	got = blocks[1]
+	require.EqualValues(t, 6, got.Height)
+	require.False(t, got.Time.Valid)
+	require.False(t, got.ProposerAddress.Valid)
+	require.Zero(t, got.TxTotal)
+
+	blocks, err = q.BlocksAfter(ctx, chain.id, 5)
+	require.NoError(t, err)
+	require.Len(t, blocks, 1)
+	require.EqualValues(t, 6, blocks[0].Height)
+
+	events, err := q.BlockEvents(ctx, chain.id, 5)
+	require.NoError(t, err)
+	require.Equal(t, []Event{
+		{Type: "timeout_packet", Attributes: []EventAttribute{{Key: "packet_sequence", Value: "1"}, {Key: "packet_src_channel", Value: "channel-0"}}},
+		{Type: "active_proposal"},
+	}, events)
+
+	events, err = q.BlockEvents(ctx, chain.id, 6)
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	txs, err := q.Transactions(ctx, chain.id)
+	require.NoError(t, err)
+	require.Len(t, txs, 2)
+	require.Zero(t, txs[0].Code)
+	require.EqualValues(t, 5, txs[1].Code)
+	require.Equal(t, "sdk", txs[1].Codespace)
+	require.Equal(t, "insufficient funds", txs[1].Log)
+	require.EqualValues(t, 50, txs[1].GasUsed)
+	require.EqualValues(t, 200, txs[1].GasWanted)
+	require.Empty(t, txs[0].Raw)
+	require.Empty(t, txs[0].DecodeError)
+	require.Equal(t, []byte{0x0a, 0x01}, txs[1].Raw)
+	require.Equal(t, "unable to resolve type URL /foo.MsgBar", txs[1].DecodeError)
+}
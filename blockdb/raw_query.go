@@ -0,0 +1,207 @@
+package blockdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// RawQueryResult is the result of an arbitrary SQL query.
+type RawQueryResult struct {
+	Columns []string
+	// Rows hold the values of the columns, which are nil, int64, float64 or string.
+	Rows [][]any
+	// Truncated is true if the query returned more rows than the limit.
+	Truncated bool
+}
+
+// RawQuery runs an arbitrary read-only SQL query, such as one typed by a user, and returns at most limit rows.
+// The query must be a single SELECT, WITH, VALUES or EXPLAIN statement. Statements which write to the database fail.
+func (q *Query) RawQuery(ctx context.Context, query string, limit int) (RawQueryResult, error) {
+	var res RawQueryResult
+	if err := checkReadOnlyQuery(query); err != nil {
+		return res, err
+	}
+
+	conn, err := q.db.Conn(ctx)
+	if err != nil {
+		return res, err
+	}
+	defer conn.Close()
+
+	// query_only prevents any change to the database file by the statement,
+	// which checkReadOnlyQuery ensures cannot turn it off.
+	if _, err := conn.ExecContext(ctx, `PRAGMA query_only = ON`); err != nil {
+		return res, fmt.Errorf("pragma query_only: %w", err)
+	}
+	defer func() {
+		// The connection returns to the pool, so it must be writable again even if ctx is done.
+		_, _ = conn.ExecContext(context.Background(), `PRAGMA query_only = OFF`)
+	}()
+
+	rows, err := conn.QueryContext(ctx, query)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+
+	if res.Columns, err = rows.Columns(); err != nil {
+		return res, err
+	}
+	for rows.Next() {
+		if len(res.Rows) == limit {
+			res.Truncated = true
+			break
+		}
+		values := make([]any, len(res.Columns))
+		ptrs := make([]any, len(values))
+		for i := range values {
+			ptrs[i] = &values[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return res, err
+		}
+		for i, v := range values {
+			if b, ok := v.([]byte); ok {
+				values[i] = string(b)
+			}
+		}
+		res.Rows = append(res.Rows, values)
+	}
+	return res, rows.Err()
+}
+
+// readOnlyKeywords are the keywords a query accepted by RawQuery may start with.
+var readOnlyKeywords = []string{"SELECT", "WITH", "VALUES", "EXPLAIN"}
+
+// checkReadOnlyQuery returns an error unless query is a single statement starting with one of readOnlyKeywords.
+// The driver runs every statement of a query, so "PRAGMA query_only = OFF; DELETE FROM tx" would otherwise
+// turn off the read-only mode before deleting. PRAGMA and ATTACH statements are rejected likewise.
+func checkReadOnlyQuery(query string) error {
+	stmts := splitStatements(query)
+	switch {
+	case len(stmts) == 0:
+		return errors.New("empty query")
+	case len(stmts) > 1:
+		return errors.New("query must be a single statement")
+	}
+	stmt := stmts[0]
+	end := strings.IndexFunc(stmt, func(r rune) bool { return !unicode.IsLetter(r) })
+	if end < 0 {
+		end = len(stmt)
+	}
+	if slices.Contains(readOnlyKeywords, strings.ToUpper(stmt[:end])) {
+		return nil
+	}
+	return fmt.Errorf("query must start with one of %s", strings.Join(readOnlyKeywords, ", "))
+}
+
+// splitStatements splits query into its statements, with comments replaced by spaces and without the
+// semicolons ending them. Semicolons in string literals and quoted identifiers are kept. Empty statements are dropped.
+func splitStatements(query string) []string {
+	var (
+		stmts []string
+		stmt  strings.Builder
+	)
+	endStatement := func() {
+		if s := strings.TrimSpace(stmt.String()); s != "" {
+			stmts = append(stmts, s)
+		}
+		stmt.Reset()
+	}
+	// skip returns the query from i up to and including end, or up to the end of the query.
+	skip := func(i int, end string) string {
+		if j := strings.Index(query[i:], end); j >= 0 {
+			return query[i : i+j+len(end)]
+		}
+		return query[i:]
+	}
+	for i := 0; i < len(query); {
+		var token string
+		switch c := query[i]; {
+		case c == ';':
+			endStatement()
+			i++
+			continue
+		case c == '\'' || c == '"' || c == '`':
+			// A doubled quote, which escapes the quote, is skipped as two quoted tokens in a row.
+			token = string(c) + skip(i+1, string(c))
+		case c == '[':
+			token = "[" + skip(i+1, "]")
+		case strings.HasPrefix(query[i:], "--"):
+			i += len("--" + skip(i+2, "\n"))
+			stmt.WriteByte(' ')
+			continue
+		case strings.HasPrefix(query[i:], "/*"):
+			i += len("/*" + skip(i+2, "*/"))
+			stmt.WriteByte(' ')
+			continue
+		default:
+			token = string(c)
+		}
+		stmt.WriteString(token)
+		i += len(token)
+	}
+	endStatement()
+	return stmts
+}
+
+// SavedQueryResult is a named query saved for later use, e.g. from the SQL console of the TUI.
+type SavedQueryResult struct {
+	Name      string
+	Query     string
+	CreatedAt time.Time
+}
+
+// SavedQueries returns the saved queries ordered by name.
+func (q *Query) SavedQueries(ctx context.Context) ([]SavedQueryResult, error) {
+	rows, err := q.db.QueryContext(ctx, `SELECT name, query, created_at FROM saved_query ORDER BY name ASC`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []SavedQueryResult
+	for rows.Next() {
+		var (
+			res       SavedQueryResult
+			createdAt string
+		)
+		if err := rows.Scan(&res.Name, &res.Query, &createdAt); err != nil {
+			return nil, err
+		}
+		t, err := timeToLocal(createdAt)
+		if err != nil {
+			return nil, fmt.Errorf("parse createdAt: %w", err)
+		}
+		res.CreatedAt = t
+		results = append(results, res)
+	}
+	return results, rows.Err()
+}
+
+// SaveQuery saves query under name, replacing any query previously saved with the same name.
+func (q *Query) SaveQuery(ctx context.Context, name, query string) error {
+	_, err := q.db.ExecContext(ctx, `INSERT INTO saved_query(name, query, created_at) VALUES (?, ?, ?)
+ON CONFLICT(name) DO UPDATE SET query = excluded.query, created_at = excluded.created_at`, name, query, nowRFC3339())
+	if err != nil {
+		return fmt.Errorf("save query %s: %w", name, err)
+	}
+	return nil
+}
+
+// DeleteSavedQuery deletes the query saved under name.
+func (q *Query) DeleteSavedQuery(ctx context.Context, name string) error {
+	res, err := q.db.ExecContext(ctx, `DELETE FROM saved_query WHERE name = ?`, name)
+	if err != nil {
+		return fmt.Errorf("delete saved query %s: %w", name, err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return fmt.Errorf("no saved query named %s", name)
+	}
+	return nil
+}
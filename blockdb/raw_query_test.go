@@ -0,0 +1,107 @@
+package blockdb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuery_RawQuery(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := migratedDB()
+	defer db.Close()
+
+	chain := validChain(t, db)
+	require.NoError(t, chain.SaveBlock(ctx, 1, []Tx{{Data: []byte(`{"test":1}`), GasUsed: 10}}))
+	require.NoError(t, chain.SaveBlock(ctx, 2, []Tx{{Data: []byte(`{"test":2}`)}, {Data: []byte(`{"test":3}`)}}))
+
+	q := NewQuery(db)
+
+	t.Run("happy path", func(t *testing.T) {
+		res, err := q.RawQuery(ctx, `SELECT block_height, tx, tx_gas_used, NULL AS missing FROM v_tx_flattened ORDER BY tx_id`, 10)
+		require.NoError(t, err)
+		require.Equal(t, []string{"block_height", "tx", "tx_gas_used", "missing"}, res.Columns)
+		require.Len(t, res.Rows, 3)
+		require.Equal(t, []any{int64(1), `{"test":1}`, int64(10), nil}, res.Rows[0])
+		require.False(t, res.Truncated)
+	})
+
+	t.Run("single statement", func(t *testing.T) {
+		for _, query := range []string{
+			`SELECT ';' AS "a;b", 1 AS [c;d] FROM tx LIMIT 1;`,
+			`select 'it''s; fine' -- trailing; comment`,
+			`/* leading; comment */ SELECT 1 UNION SELECT 2; ;`,
+			`WITH t AS (SELECT 1) SELECT * FROM t`,
+			`EXPLAIN QUERY PLAN SELECT * FROM tx`,
+			`VALUES (1)`,
+		} {
+			_, err := q.RawQuery(ctx, query, 10)
+			require.NoError(t, err, query)
+		}
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		res, err := q.RawQuery(ctx, `SELECT id FROM tx`, 2)
+		require.NoError(t, err)
+		require.Len(t, res.Rows, 2)
+		require.True(t, res.Truncated)
+	})
+
+	t.Run("read only", func(t *testing.T) {
+		_, err := q.RawQuery(ctx, `DELETE FROM tx`, 10)
+		require.Error(t, err)
+		_, err = q.RawQuery(ctx, `  `, 10)
+		require.Error(t, err)
+
+		// The driver runs every statement of a query, which must not turn off the read-only mode.
+		_, err = q.RawQuery(ctx, `PRAGMA query_only = OFF; DELETE FROM schema_version`, 10)
+		require.Error(t, err)
+		_, err = q.RawQuery(ctx, `SELECT 1; PRAGMA query_only = OFF; DELETE FROM schema_version`, 10)
+		require.Error(t, err)
+		_, err = q.RawQuery(ctx, `SELECT 1 /* ; */; -- ;
+DELETE FROM schema_version`, 10)
+		require.Error(t, err)
+		_, err = q.RawQuery(ctx, `ATTACH DATABASE ':memory:' AS other`, 10)
+		require.Error(t, err)
+		var versions int
+		require.NoError(t, db.QueryRow(`SELECT count(*) FROM schema_version`).Scan(&versions))
+		require.NotZero(t, versions)
+
+		// The connection is writable again for the collector.
+		require.NoError(t, chain.SaveBlock(ctx, 3, nil))
+		var count int
+		require.NoError(t, db.QueryRow(`SELECT count(*) FROM tx`).Scan(&count))
+		require.Equal(t, 3, count)
+	})
+}
+
+func TestQuery_SavedQueries(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := migratedDB()
+	defer db.Close()
+
+	q := NewQuery(db)
+	require.NoError(t, q.SaveQuery(ctx, "txs", `SELECT * FROM tx`))
+	require.NoError(t, q.SaveQuery(ctx, "blocks", `SELECT * FROM block`))
+	require.NoError(t, q.SaveQuery(ctx, "txs", `SELECT data FROM tx`))
+
+	saved, err := q.SavedQueries(ctx)
+	require.NoError(t, err)
+	require.Len(t, saved, 2)
+	require.Equal(t, "blocks", saved[0].Name)
+	require.Equal(t, "txs", saved[1].Name)
+	require.Equal(t, `SELECT data FROM tx`, saved[1].Query)
+	require.False(t, saved[1].CreatedAt.IsZero())
+
+	require.NoError(t, q.DeleteSavedQuery(ctx, "blocks"))
+	require.Error(t, q.DeleteSavedQuery(ctx, "blocks"))
+
+	saved, err = q.SavedQueries(ctx)
+	require.NoError(t, err)
+	require.Len(t, saved, 1)
+}
@@ -27,6 +27,11 @@ func CreateTestCase(ctx context.Context, db *sql.DB, testName, gitSha string) (*
 	}, nil
 }
 
+// ID is the primary key of the test case, which identifies it in queries.
+func (tc *TestCase) ID() int64 {
+	return tc.id
+}
+
 // AddChain tracks and attaches a chain to the test case.
 // The chainID must be unique per test case. E.g. osmosis-1001, cosmos-1004
 // The chainType denotes which ecosystem the chain belongs to. E.g. cosmos, penumbra, composable, etc.
@@ -0,0 +1,90 @@
+package tui
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/rivo/tview"
+)
+
+type exportFormat string
+
+const (
+	exportCSV  exportFormat = "csv"
+	exportJSON exportFormat = "json"
+)
+
+// exportTable writes the rows of a table built by detailTableView to a new file in dir, and returns its path.
+// Cells export their reference if set, e.g. the raw values of SQL results, otherwise their text.
+// A JSON export is an array with an object per row, keyed by the headers.
+func exportTable(tbl *tview.Table, dir, name string, format exportFormat) (string, error) {
+	headers := make([]string, tbl.GetColumnCount())
+	for col := range headers {
+		headers[col] = fmt.Sprint(cellValue(tbl.GetCell(0, col)))
+	}
+	rows := make([][]any, 0, tbl.GetRowCount())
+	for row := 1; row < tbl.GetRowCount(); row++ {
+		values := make([]any, len(headers))
+		for col := range values {
+			values[col] = cellValue(tbl.GetCell(row, col))
+		}
+		rows = append(rows, values)
+	}
+
+	path := filepath.Join(dir, fmt.Sprintf("%s-%s.%s", name, time.Now().Format("20060102-150405"), format))
+	f, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	switch format {
+	case exportCSV:
+		w := csv.NewWriter(f)
+		records := make([][]string, 0, len(rows)+1)
+		records = append(records, headers)
+		for _, row := range rows {
+			record := make([]string, len(row))
+			for i, v := range row {
+				if v != nil {
+					record[i] = fmt.Sprint(v)
+				}
+			}
+			records = append(records, record)
+		}
+		err = w.WriteAll(records)
+	case exportJSON:
+		objects := make([]map[string]any, len(rows))
+		for i, row := range rows {
+			objects[i] = make(map[string]any, len(headers))
+			for col, v := range row {
+				objects[i][headers[col]] = v
+			}
+		}
+		enc := json.NewEncoder(f)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(objects)
+	default:
+		err = fmt.Errorf("unknown export format %q", format)
+	}
+	if err != nil {
+		return "", err
+	}
+	return path, f.Close()
+}
+
+// cellRef is the reference of a table cell which exports a value other than its text.
+type cellRef struct {
+	value any
+}
+
+func cellValue(cell *tview.TableCell) any {
+	if ref, ok := cell.GetReference().(cellRef); ok {
+		return ref.value
+	}
+	return cell.Text
+}
@@ -39,9 +39,43 @@ var (
 		{"ctrl+f", "page down"},
 	}
 
+	exportKeys = []keyBinding{
+		{"e", "export csv"},
+		{"shift+e", "export json"},
+	}
+
 	keyMap = map[mainContent][]keyBinding{
-		testCasesMain:      bindingsWithBase([]keyBinding{{"m", "cosmos messages"}, {"enter", "view txs"}}, tableNavKeys),
-		cosmosMessagesMain: bindingsWithBase(tableNavKeys),
+		testCasesMain: bindingsWithBase([]keyBinding{
+			{"m", "cosmos messages"},
+			{"b", "blocks"},
+			{"p", "ibc packets"},
+			{"s", "sql console"},
+			{"c", "compare with another run"},
+			{"l", "follow blocks and txs"},
+			{"space", "pause/resume refresh"},
+			{"enter", "view txs"},
+		}, exportKeys, tableNavKeys),
+		cosmosMessagesMain: bindingsWithBase(exportKeys, tableNavKeys),
+		blocksMain:         bindingsWithBase([]keyBinding{{"enter", "view block"}}, exportKeys, tableNavKeys),
+		blockDetailMain:    bindingsWithBase(textNavKeys),
+		packetsMain:        bindingsWithBase(exportKeys, tableNavKeys),
+		sqlConsoleMain: bindingsWithBase([]keyBinding{
+			{"enter", "run query or view row"},
+			{"tab", "switch to query/results"},
+			{".saved", "list saved queries"},
+			{".save NAME", "save last query"},
+			{".run NAME", "run saved query"},
+			{".delete NAME", "delete saved query"},
+		}, exportKeys, tableNavKeys),
+		sqlRowMain:        bindingsWithBase(textNavKeys),
+		compareMain:       bindingsWithBase([]keyBinding{{"enter", "view block of both runs"}}, exportKeys, tableNavKeys),
+		compareDetailMain: bindingsWithBase(textNavKeys),
+		liveMain: bindingsWithBase([]keyBinding{
+			{"enter", "view block"},
+			{"tab", "switch to blocks/txs"},
+			{"space", "pause/resume"},
+		}, exportKeys, tableNavKeys),
+		messageModalMain: bindingsWithBase(nil),
 		txDetailMain: bindingsWithBase([]keyBinding{
 			{"[", "previous tx"},
 			{"]", "next tx"},
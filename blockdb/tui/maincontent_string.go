@@ -11,12 +11,21 @@ func _() {
 	_ = x[testCasesMain-0]
 	_ = x[cosmosMessagesMain-1]
 	_ = x[txDetailMain-2]
-	_ = x[errorModalMain-3]
+	_ = x[blocksMain-3]
+	_ = x[blockDetailMain-4]
+	_ = x[packetsMain-5]
+	_ = x[sqlConsoleMain-6]
+	_ = x[sqlRowMain-7]
+	_ = x[compareMain-8]
+	_ = x[compareDetailMain-9]
+	_ = x[liveMain-10]
+	_ = x[messageModalMain-11]
+	_ = x[errorModalMain-12]
 }
 
-const _mainContent_name = "testCasesMaincosmosMessagesMaintxDetailMainerrorModalMain"
+const _mainContent_name = "testCasesMaincosmosMessagesMaintxDetailMainblocksMainblockDetailMainpacketsMainsqlConsoleMainsqlRowMaincompareMaincompareDetailMainliveMainmessageModalMainerrorModalMain"
 
-var _mainContent_index = [...]uint8{0, 13, 31, 43, 57}
+var _mainContent_index = [...]uint8{0, 13, 31, 43, 53, 68, 79, 93, 103, 114, 131, 139, 155, 169}
 
 func (i mainContent) String() string {
 	if i < 0 || i >= mainContent(len(_mainContent_index)-1) {
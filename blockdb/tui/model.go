@@ -2,6 +2,8 @@ package tui
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"time"
 
 	"github.com/atotto/clipboard"
@@ -18,6 +20,15 @@ const (
 	testCasesMain mainContent = iota
 	cosmosMessagesMain
 	txDetailMain
+	blocksMain
+	blockDetailMain
+	packetsMain
+	sqlConsoleMain
+	sqlRowMain
+	compareMain
+	compareDetailMain
+	liveMain
+	messageModalMain
 	errorModalMain
 )
 
@@ -27,10 +38,24 @@ func (stack mainStack) Push(s mainContent) []mainContent { return append(stack,
 func (stack mainStack) Current() mainContent             { return stack[len(stack)-1] }
 func (stack mainStack) Pop() []mainContent               { return stack[:len(stack)-1] }
 
+// RecentTestCasesLimit is the number of test case and chain combinations listed.
+const RecentTestCasesLimit = 100
+
 // QueryService fetches data from a database.
 type QueryService interface {
+	RecentTestCases(ctx context.Context, limit int) ([]blockdb.TestCaseResult, error)
 	CosmosMessages(ctx context.Context, chainPkey int64) ([]blockdb.CosmosMessageResult, error)
 	Transactions(ctx context.Context, chainPkey int64) ([]blockdb.TxResult, error)
+	Blocks(ctx context.Context, chainPkey int64) ([]blockdb.BlockResult, error)
+	BlocksAfter(ctx context.Context, chainPkey int64, height int64) ([]blockdb.BlockResult, error)
+	TransactionsAfter(ctx context.Context, chainPkey int64, height int64) ([]blockdb.TxResult, error)
+	BlockEvents(ctx context.Context, chainPkey int64, height int64) ([]blockdb.Event, error)
+	Packets(ctx context.Context, testCaseID int64) ([]blockdb.PacketResult, error)
+	RawQuery(ctx context.Context, query string, limit int) (blockdb.RawQueryResult, error)
+	SavedQueries(ctx context.Context) ([]blockdb.SavedQueryResult, error)
+	SaveQuery(ctx context.Context, name, query string) error
+	DeleteSavedQuery(ctx context.Context, name string) error
+	CompareTestCases(ctx context.Context, testCaseA, testCaseB int64) ([]blockdb.BlockComparison, error)
 }
 
 // Model encapsulates state that updates a view.
@@ -41,13 +66,32 @@ type Model struct {
 	schemaDate    time.Time
 	testCases     []blockdb.TestCaseResult
 
-	layout *tview.Flex
+	layout         *tview.Flex
+	testCasesTable *tview.Table
 
 	// stack keeps tracks of primary content pushed and popped
 	stack mainStack
 
 	// write to the system clipboard
 	clipboard func(text string) error
+
+	// directory where tables are exported, the working directory if empty
+	exportDir string
+
+	// test case marked to be compared with the next one marked
+	compareWith *blockdb.TestCaseResult
+
+	// Refresh does nothing while paused.
+	paused bool
+	// live follows a chain, while its page is in the stack.
+	live *liveView
+
+	// Copies of paused and live for Refresh, which runs apart from the main goroutine. Set with syncRefresh.
+	refreshMu     sync.Mutex
+	refreshPaused bool
+	refreshLive   *liveView
+	// Height of refreshLive when copied.
+	refreshHeight int64
 }
 
 // NewModel returns a valid *Model.
@@ -76,7 +120,8 @@ func NewModel(
 	// The primary view is a page view to act like a stack where we can push and pop views.
 	// Flex and grid views do not allow a "stack-like" behavior.
 	pages := tview.NewPages()
-	pages.AddAndSwitchToPage(m.stack[0].String(), testCasesView(m), true)
+	m.testCasesTable = testCasesView(m)
+	pages.AddAndSwitchToPage(m.stack[0].String(), m.testCasesTable, true)
 	flex.AddItem(pages, 0, 10, true)
 
 	m.layout = flex
@@ -88,3 +133,129 @@ func NewModel(
 func (m *Model) RootView() *tview.Flex {
 	return m.layout
 }
+
+// Refresh follows the database while tests are writing to it: it queries the new test cases and chain heights,
+// and the blocks and txs saved since the last refresh for the live view, if any, and returns a func which shows them.
+// Unlike Update, Refresh may be called from any goroutine, so that the queries do not block the UI,
+// but the returned func must be run on the main goroutine, e.g. through *(tview.Application).QueueUpdateDraw.
+func (m *Model) Refresh(ctx context.Context) (show func()) {
+	m.refreshMu.Lock()
+	paused, live, height := m.refreshPaused, m.refreshLive, m.refreshHeight
+	m.refreshMu.Unlock()
+	if paused {
+		return func() {}
+	}
+
+	testCases, blocks, txs, err := m.queryRefresh(ctx, live, height)
+	return func() {
+		if m.paused {
+			// Paused while querying.
+			return
+		}
+		if err != nil {
+			// Pause, so that the error is not shown again on every refresh.
+			m.setPaused(true)
+			m.pushErrorModal(fmt.Errorf("refresh: %w", err))
+			return
+		}
+		m.replaceTestCases(testCases)
+
+		if m.live == nil || !m.mainContentView().HasPage(liveMain.String()) {
+			m.live = nil
+		} else if m.live == live && m.live.Height == height {
+			// Otherwise the live view changed while querying, and the next refresh queries it anew.
+			m.live.Append(blocks, txs)
+		}
+		m.syncRefresh()
+	}
+}
+
+func (m *Model) queryRefresh(ctx context.Context, live *liveView, height int64) (
+	testCases []blockdb.TestCaseResult, blocks []blockdb.BlockResult, txs []blockdb.TxResult, err error,
+) {
+	testCases, err = m.querySvc.RecentTestCases(ctx, RecentTestCasesLimit)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("query recent test cases: %w", err)
+	}
+	if live == nil {
+		return testCases, nil, nil, nil
+	}
+	blocks, txs, err = m.queryLive(ctx, live.ChainPKey, height)
+	return testCases, blocks, txs, err
+}
+
+// appendLive appends the blocks and txs saved since the last block shown by the live view.
+func (m *Model) appendLive(ctx context.Context, live *liveView) error {
+	blocks, txs, err := m.queryLive(ctx, live.ChainPKey, live.Height)
+	if err != nil {
+		return err
+	}
+	live.Append(blocks, txs)
+	return nil
+}
+
+// queryLive queries the blocks and txs of the chain saved after height.
+// It only reads its arguments, so that Refresh can call it apart from the main goroutine.
+func (m *Model) queryLive(ctx context.Context, chainPKey, height int64) ([]blockdb.BlockResult, []blockdb.TxResult, error) {
+	blocks, err := m.querySvc.BlocksAfter(ctx, chainPKey, height)
+	if err != nil {
+		return nil, nil, fmt.Errorf("query blocks: %w", err)
+	}
+	if len(blocks) == 0 {
+		return nil, nil, nil
+	}
+	txs, err := m.querySvc.TransactionsAfter(ctx, chainPKey, height)
+	if err != nil {
+		return nil, nil, fmt.Errorf("query transactions: %w", err)
+	}
+	// Blocks saved after querying the blocks are appended with their txs by the next call.
+	last := blocks[len(blocks)-1].Height
+	for i, tx := range txs {
+		if tx.Height > last {
+			txs = txs[:i]
+			break
+		}
+	}
+	return blocks, txs, nil
+}
+
+// replaceTestCases keeps the same test case and chain selected, as new test cases are listed first.
+func (m *Model) replaceTestCases(testCases []blockdb.TestCaseResult) {
+	tbl := m.testCasesTable
+	row, _ := tbl.GetSelection()
+	var selected int64 = -1
+	if row > 0 && row <= len(m.testCases) {
+		selected = m.testCases[row-1].ChainPKey
+	}
+
+	m.testCases = testCases
+	for tbl.GetRowCount() > 1 {
+		tbl.RemoveRow(tbl.GetRowCount() - 1)
+	}
+	for i, r := range testCaseRows(testCases) {
+		appendTableRow(tbl, r)
+		if testCases[i].ChainPKey == selected {
+			tbl.Select(i+1, 0)
+		}
+	}
+}
+
+func (m *Model) setPaused(paused bool) {
+	m.paused = paused
+	if m.live != nil {
+		m.live.SetPaused(paused)
+	}
+	m.syncRefresh()
+}
+
+// syncRefresh copies the state Refresh reads. It must be called on the main goroutine after changing paused or live.
+func (m *Model) syncRefresh() {
+	m.refreshMu.Lock()
+	defer m.refreshMu.Unlock()
+	m.refreshPaused = m.paused
+	m.refreshLive = m.live
+	m.refreshHeight = 0
+	if m.live != nil {
+		m.refreshHeight = m.live.Height
+	}
+}
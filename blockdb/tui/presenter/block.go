@@ -0,0 +1,82 @@
+package presenter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/strangelove-ventures/interchaintest/v8/blockdb"
+)
+
+// Block presents a blockdb.BlockResult.
+type Block struct {
+	Result blockdb.BlockResult
+}
+
+func (b Block) Height() string { return strconv.FormatInt(b.Result.Height, 10) }
+
+func (b Block) Time() string {
+	if !b.Result.Time.Valid {
+		return ""
+	}
+	return b.Result.Time.Time.Format("15:04:05.000")
+}
+
+func (b Block) Proposer() string { return shortHash(b.Result.ProposerAddress.String) }
+func (b Block) AppHash() string  { return shortHash(b.Result.AppHash.String) }
+
+// Txs is the tx total, with the failed txs if any.
+func (b Block) Txs() string {
+	total := strconv.FormatInt(b.Result.TxTotal, 10)
+	if b.Result.FailedTxTotal == 0 {
+		return total
+	}
+	return fmt.Sprintf("%s (%d failed)", total, b.Result.FailedTxTotal)
+}
+
+// Gas is the gas used out of the gas wanted by the txs.
+func (b Block) Gas() string {
+	return fmt.Sprintf("%d/%d", b.Result.GasUsed, b.Result.GasWanted)
+}
+
+func (b Block) Events() string { return strconv.FormatInt(b.Result.EventTotal, 10) }
+
+// Detail describes the full block header and the block-level events.
+func (b Block) Detail(events []blockdb.Event) string {
+	var sb strings.Builder
+	line := func(name, value string) {
+		if value == "" {
+			value = "unknown"
+		}
+		fmt.Fprintf(&sb, "%-16s %s\n", name+":", value)
+	}
+	var blockTime string
+	if b.Result.Time.Valid {
+		blockTime = b.Result.Time.Time.Format("2006-01-02 15:04:05.000 MST")
+	}
+	line("Height", b.Height())
+	line("Time", blockTime)
+	line("Proposer", b.Result.ProposerAddress.String)
+	line("App Hash", b.Result.AppHash.String)
+	line("Validators Hash", b.Result.ValidatorsHash.String)
+	line("Txs", b.Txs())
+	line("Gas", b.Gas())
+
+	fmt.Fprintf(&sb, "\nBlock Events (%d):\n", len(events))
+	for _, e := range events {
+		fmt.Fprintf(&sb, "\n%s\n", e.Type)
+		for _, attr := range e.Attributes {
+			fmt.Fprintf(&sb, "  %s: %s\n", attr.Key, attr.Value)
+		}
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// shortHash abbreviates a hex hash or address, which is too long for a table column.
+func shortHash(s string) string {
+	const maxLen = 12
+	if len(s) <= maxLen {
+		return s
+	}
+	return s[:maxLen] + "…"
+}
@@ -0,0 +1,52 @@
+package presenter
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/strangelove-ventures/interchaintest/v8/blockdb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("with header", func(t *testing.T) {
+		blockTime := time.Date(2024, 5, 1, 12, 30, 15, 250_000_000, time.UTC)
+		pres := Block{blockdb.BlockResult{
+			Height:          42,
+			Time:            sql.NullTime{Time: blockTime, Valid: true},
+			ProposerAddress: sql.NullString{String: "0123456789ABCDEF0123", Valid: true},
+			AppHash:         sql.NullString{String: "ABCD", Valid: true},
+			TxTotal:         3,
+			FailedTxTotal:   1,
+			GasUsed:         150,
+			GasWanted:       400,
+			EventTotal:      2,
+		}}
+
+		require.Equal(t, "42", pres.Height())
+		require.Equal(t, "12:30:15.250", pres.Time())
+		require.Equal(t, "0123456789AB…", pres.Proposer())
+		require.Equal(t, "ABCD", pres.AppHash())
+		require.Equal(t, "3 (1 failed)", pres.Txs())
+		require.Equal(t, "150/400", pres.Gas())
+		require.Equal(t, "2", pres.Events())
+
+		detail := pres.Detail([]blockdb.Event{
+			{Type: "timeout_packet", Attributes: []blockdb.EventAttribute{{Key: "packet_sequence", Value: "1"}}},
+		})
+		require.Contains(t, detail, "Proposer:        0123456789ABCDEF0123")
+		require.Contains(t, detail, "Validators Hash: unknown")
+		require.Contains(t, detail, "Block Events (1):\n\ntimeout_packet\n  packet_sequence: 1")
+	})
+
+	t.Run("without header", func(t *testing.T) {
+		pres := Block{blockdb.BlockResult{Height: 1, TxTotal: 2}}
+
+		require.Empty(t, pres.Time())
+		require.Empty(t, pres.Proposer())
+		require.Equal(t, "2", pres.Txs())
+	})
+}
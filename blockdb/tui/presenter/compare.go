@@ -0,0 +1,93 @@
+package presenter
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/strangelove-ventures/interchaintest/v8/blockdb"
+)
+
+// BlockComparison presents a blockdb.BlockComparison, with the values of both runs side by side as "A | B".
+type BlockComparison struct {
+	Result blockdb.BlockComparison
+}
+
+// Chain is the chain ID, or the chain ID in both runs if they differ.
+func (c BlockComparison) Chain() string {
+	if c.Result.ChainIDB != "" {
+		return c.Result.ChainID + " | " + c.Result.ChainIDB
+	}
+	return c.Result.ChainID
+}
+
+func (c BlockComparison) Height() string { return strconv.FormatInt(c.Result.Height, 10) }
+
+func (c BlockComparison) Txs() string {
+	return c.sideBySide(func(s *blockdb.BlockSummary) string { return strconv.FormatInt(s.TxTotal, 10) })
+}
+
+func (c BlockComparison) Failures() string {
+	return c.sideBySide(func(s *blockdb.BlockSummary) string { return strconv.FormatInt(s.FailedTxTotal, 10) })
+}
+
+func (c BlockComparison) Messages() string {
+	return c.sideBySide(func(s *blockdb.BlockSummary) string { return strconv.Itoa(len(s.MsgTypes)) })
+}
+
+func (c BlockComparison) Events() string {
+	return c.sideBySide(func(s *blockdb.BlockSummary) string { return strconv.Itoa(s.EventTotal()) })
+}
+
+// Gas is the gas used in both runs.
+func (c BlockComparison) Gas() string {
+	return c.sideBySide(func(s *blockdb.BlockSummary) string { return strconv.FormatInt(s.GasUsed, 10) })
+}
+
+func (c BlockComparison) Differences() string { return strings.Join(c.Result.Differences(), ", ") }
+
+func (c BlockComparison) sideBySide(value func(s *blockdb.BlockSummary) string) string {
+	a, b := "-", "-"
+	if c.Result.A != nil {
+		a = value(c.Result.A)
+	}
+	if c.Result.B != nil {
+		b = value(c.Result.B)
+	}
+	return a + " | " + b
+}
+
+// Detail lists the message types and event counts of both runs.
+func (c BlockComparison) Detail() string {
+	var sb strings.Builder
+	for _, run := range []struct {
+		name    string
+		summary *blockdb.BlockSummary
+	}{{"A", c.Result.A}, {"B", c.Result.B}} {
+		if run.summary == nil {
+			fmt.Fprintf(&sb, "Run %s: block not saved\n\n", run.name)
+			continue
+		}
+		s := run.summary
+		fmt.Fprintf(&sb, "Run %s: %d txs (%d failed), gas %d/%d\n", run.name, s.TxTotal, s.FailedTxTotal, s.GasUsed, s.GasWanted)
+		fmt.Fprintf(&sb, "\n  Messages (%d):\n", len(s.MsgTypes))
+		for _, msgType := range s.MsgTypes {
+			fmt.Fprintf(&sb, "    %s\n", msgType)
+		}
+		eventTypes := make([]string, 0, len(s.Events))
+		for typ := range s.Events {
+			eventTypes = append(eventTypes, typ)
+		}
+		sort.Strings(eventTypes)
+		fmt.Fprintf(&sb, "\n  Events (%d):\n", s.EventTotal())
+		for _, typ := range eventTypes {
+			fmt.Fprintf(&sb, "    %s: %d\n", typ, s.Events[typ])
+		}
+		sb.WriteString("\n")
+	}
+	if diffs := c.Differences(); diffs != "" {
+		fmt.Fprintf(&sb, "Differences: %s", diffs)
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
@@ -0,0 +1,52 @@
+package presenter
+
+import (
+	"testing"
+
+	"github.com/strangelove-ventures/interchaintest/v8/blockdb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockComparison(t *testing.T) {
+	t.Parallel()
+
+	pres := BlockComparison{blockdb.BlockComparison{
+		ChainID: "chain-a",
+		Height:  7,
+		A: &blockdb.BlockSummary{
+			TxTotal:  1,
+			GasUsed:  100,
+			MsgTypes: []string{"/cosmos.bank.v1beta1.MsgSend"},
+			Events:   map[string]int{"transfer": 2, "message": 1},
+		},
+		B: &blockdb.BlockSummary{
+			TxTotal:       1,
+			FailedTxTotal: 1,
+			GasUsed:       80,
+			MsgTypes:      []string{"/cosmos.bank.v1beta1.MsgSend"},
+			Events:        map[string]int{"message": 1},
+		},
+	}}
+
+	require.Equal(t, "chain-a", pres.Chain())
+	require.Equal(t, "7", pres.Height())
+	require.Equal(t, "1 | 1", pres.Txs())
+	require.Equal(t, "0 | 1", pres.Failures())
+	require.Equal(t, "1 | 1", pres.Messages())
+	require.Equal(t, "3 | 1", pres.Events())
+	require.Equal(t, "100 | 80", pres.Gas())
+	require.Equal(t, "failures, events, gas", pres.Differences())
+
+	detail := pres.Detail()
+	require.Contains(t, detail, "Run A: 1 txs (0 failed), gas 100/0")
+	require.Contains(t, detail, "  Events (3):\n    message: 1\n    transfer: 2")
+	require.Contains(t, detail, "Run B: 1 txs (1 failed)")
+	require.Contains(t, detail, "Differences: failures, events, gas")
+
+	missing := BlockComparison{blockdb.BlockComparison{ChainID: "chain-b", Height: 1, B: &blockdb.BlockSummary{}}}
+	require.Equal(t, "- | 0", missing.Txs())
+	require.Contains(t, missing.Detail(), "Run A: block not saved")
+
+	suffixed := BlockComparison{blockdb.BlockComparison{ChainID: "gaia-1", ChainIDB: "gaia-7", Height: 1}}
+	require.Equal(t, "gaia-1 | gaia-7", suffixed.Chain())
+}
@@ -0,0 +1,67 @@
+package presenter
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/strangelove-ventures/interchaintest/v8/blockdb"
+)
+
+// Packet presents a blockdb.PacketResult.
+type Packet struct {
+	Result blockdb.PacketResult
+}
+
+// Source is the source chain with the port and channel the packet was sent on.
+func (p Packet) Source() string {
+	return fmt.Sprintf("%s %s/%s", p.Result.SrcChainID, p.Result.SrcPort, p.Result.SrcChannel)
+}
+
+// Destination is the destination port and channel, with the chain once the packet is received.
+func (p Packet) Destination() string {
+	dst := fmt.Sprintf("%s/%s", p.Result.DstPort, p.Result.DstChannel)
+	if p.Result.DstChainID == "" {
+		return dst
+	}
+	return p.Result.DstChainID + " " + dst
+}
+
+func (p Packet) Sequence() string { return strconv.FormatInt(p.Result.Sequence, 10) }
+
+// Sent is the height and time at which the packet was sent.
+func (p Packet) Sent() string {
+	return fmt.Sprintf("%d @ %s", p.Result.Send.Height, p.Result.Send.Time.Format("15:04:05.000"))
+}
+
+// Recv is the time it took to receive the packet, or empty if it was not received.
+func (p Packet) Recv() string {
+	if p.Result.Recv == nil {
+		return ""
+	}
+	return formatDuration(p.Result.RecvDuration())
+}
+
+// Ack is the time it took to relay the acknowledgement once written, or empty if the packet was not acknowledged.
+func (p Packet) Ack() string {
+	if p.Result.Ack == nil || p.Result.WriteAck == nil {
+		return ""
+	}
+	return formatDuration(p.Result.AckDuration())
+}
+
+// Status is where the packet stalled, or how its lifecycle ended with the total time it took.
+func (p Packet) Status() string {
+	switch {
+	case p.Result.Ack != nil:
+		return "acknowledged in " + formatDuration(p.Result.TotalDuration())
+	case p.Result.Timeout != nil:
+		return "timed out in " + formatDuration(p.Result.TotalDuration())
+	default:
+		return "awaiting " + p.Result.Stalled()
+	}
+}
+
+func formatDuration(d time.Duration) string {
+	return d.Round(time.Millisecond).String()
+}
@@ -0,0 +1,60 @@
+package presenter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/strangelove-ventures/interchaintest/v8/blockdb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPacket(t *testing.T) {
+	t.Parallel()
+
+	sent := time.Date(2024, 5, 1, 12, 30, 15, 0, time.UTC)
+	at := func(height int64, d time.Duration) *blockdb.PacketEvent {
+		return &blockdb.PacketEvent{Height: height, Time: sent.Add(d)}
+	}
+	result := blockdb.PacketResult{
+		SrcChainID: "chain-a",
+		SrcPort:    "transfer",
+		SrcChannel: "channel-0",
+		DstPort:    "transfer",
+		DstChannel: "channel-1",
+		Sequence:   7,
+		Send:       blockdb.PacketEvent{Height: 10, Time: sent},
+	}
+
+	t.Run("in flight", func(t *testing.T) {
+		pres := Packet{result}
+
+		require.Equal(t, "chain-a transfer/channel-0", pres.Source())
+		require.Equal(t, "transfer/channel-1", pres.Destination())
+		require.Equal(t, "7", pres.Sequence())
+		require.Equal(t, "10 @ 12:30:15.000", pres.Sent())
+		require.Empty(t, pres.Recv())
+		require.Empty(t, pres.Ack())
+		require.Equal(t, "awaiting recv_packet", pres.Status())
+	})
+
+	t.Run("acknowledged", func(t *testing.T) {
+		acked := result
+		acked.DstChainID = "chain-b"
+		acked.Recv = at(5, 1500*time.Millisecond)
+		acked.WriteAck = acked.Recv
+		acked.Ack = at(13, 4*time.Second)
+		pres := Packet{acked}
+
+		require.Equal(t, "chain-b transfer/channel-1", pres.Destination())
+		require.Equal(t, "1.5s", pres.Recv())
+		require.Equal(t, "2.5s", pres.Ack())
+		require.Equal(t, "acknowledged in 4s", pres.Status())
+	})
+
+	t.Run("timed out", func(t *testing.T) {
+		timedOut := result
+		timedOut.Timeout = at(30, time.Minute)
+
+		require.Equal(t, "timed out in 1m0s", Packet{timedOut}.Status())
+	})
+}
@@ -0,0 +1,49 @@
+package presenter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// SQLValue presents a value returned by blockdb.Query.RawQuery in a table cell.
+// JSON values, such as txs, are compacted to a single line.
+func SQLValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "NULL"
+	case string:
+		if isJSON(v) {
+			var buf bytes.Buffer
+			if err := json.Compact(&buf, []byte(v)); err == nil {
+				return buf.String()
+			}
+		}
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// SQLRow describes a row returned by blockdb.Query.RawQuery, one column per line.
+// JSON values, such as txs, are indented like in the tx detail.
+func SQLRow(columns []string, values []any) string {
+	var sb strings.Builder
+	for i, col := range columns {
+		if s, ok := values[i].(string); ok && isJSON(s) {
+			var buf bytes.Buffer
+			if err := json.Indent(&buf, []byte(s), "", "  "); err == nil {
+				fmt.Fprintf(&sb, "%s:\n%s\n", col, buf.String())
+				continue
+			}
+		}
+		fmt.Fprintf(&sb, "%s: %s\n", col, SQLValue(values[i]))
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+func isJSON(s string) bool {
+	s = strings.TrimSpace(s)
+	return (strings.HasPrefix(s, "{") || strings.HasPrefix(s, "[")) && json.Valid([]byte(s))
+}
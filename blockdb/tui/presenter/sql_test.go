@@ -0,0 +1,25 @@
+package presenter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLValue(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "NULL", SQLValue(nil))
+	require.Equal(t, "42", SQLValue(int64(42)))
+	require.Equal(t, "1.5", SQLValue(1.5))
+	require.Equal(t, "cosmos", SQLValue("cosmos"))
+	require.Equal(t, `{"a":[1,2]}`, SQLValue("{\n  \"a\": [1, 2]\n}"))
+	require.Equal(t, "{not json", SQLValue("{not json"))
+}
+
+func TestSQLRow(t *testing.T) {
+	t.Parallel()
+
+	got := SQLRow([]string{"height", "tx", "log"}, []any{int64(3), `{"body":{"memo":"hi"}}`, nil})
+	require.Equal(t, "height: 3\ntx:\n{\n  \"body\": {\n    \"memo\": \"hi\"\n  }\n}\nlog: NULL", got)
+}
@@ -3,7 +3,9 @@ package presenter
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/strangelove-ventures/interchaintest/v8/blockdb"
@@ -29,6 +31,63 @@ func (tx Tx) Data() string {
 	return buf.String()
 }
 
+// Status describes the result of the tx.
+func (tx Tx) Status() string {
+	if tx.Result.Code == 0 {
+		return "ok"
+	}
+	if tx.Result.Codespace == "" {
+		return fmt.Sprintf("failed with code %d", tx.Result.Code)
+	}
+	return fmt.Sprintf("failed with code %d (%s)", tx.Result.Code, tx.Result.Codespace)
+}
+
+// Gas is the gas used out of the gas wanted by the tx.
+func (tx Tx) Gas() string {
+	return fmt.Sprintf("%d/%d", tx.Result.GasUsed, tx.Result.GasWanted)
+}
+
+// Messages lists the types of the cosmos messages of the tx, e.g. /cosmos.bank.v1beta1.MsgSend,
+// or is empty if the tx is not a JSON cosmos tx.
+func (tx Tx) Messages() string {
+	var cosmosTx struct {
+		Body struct {
+			Messages []struct {
+				Type string `json:"@type"`
+			} `json:"messages"`
+		} `json:"body"`
+	}
+	if err := json.Unmarshal(tx.Result.Tx, &cosmosTx); err != nil {
+		return ""
+	}
+	types := make([]string, len(cosmosTx.Body.Messages))
+	for i, msg := range cosmosTx.Body.Messages {
+		types[i] = msg.Type
+	}
+	return strings.Join(types, ", ")
+}
+
+// DecodeStatus is empty if the tx was decoded, otherwise it says that decoding failed.
+func (tx Tx) DecodeStatus() string {
+	if tx.Result.DecodeError == "" {
+		return ""
+	}
+	return "decode failed"
+}
+
+// Detail is the tx data followed by the log of a failed tx, which explains the failure.
+// If the tx did not decode, the decoding error comes first.
+func (tx Tx) Detail() string {
+	detail := tx.Data()
+	if tx.Result.Code != 0 && tx.Result.Log != "" {
+		detail += "\n\nLog: " + tx.Result.Log
+	}
+	if tx.Result.DecodeError != "" {
+		detail = "Decode error: " + tx.Result.DecodeError + "\n\n" + detail
+	}
+	return detail
+}
+
 type Txs []blockdb.TxResult
 
 // ToJSON always renders valid JSON given the blockdb.TxResult.
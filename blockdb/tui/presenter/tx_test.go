@@ -38,6 +38,33 @@ func TestTx(t *testing.T) {
 	})
 }
 
+func TestTx_Result(t *testing.T) {
+	t.Parallel()
+
+	ok := Tx{blockdb.TxResult{Tx: []byte(`ok`), GasUsed: 10, GasWanted: 20, Log: "[]"}}
+	require.Equal(t, "ok", ok.Status())
+	require.Equal(t, "10/20", ok.Gas())
+	require.Equal(t, "ok", ok.Detail())
+
+	failed := Tx{blockdb.TxResult{Tx: []byte(`failed`), Code: 5, Codespace: "sdk", Log: "insufficient funds"}}
+	require.Equal(t, "failed with code 5 (sdk)", failed.Status())
+	require.Equal(t, "failed\n\nLog: insufficient funds", failed.Detail())
+	require.Empty(t, failed.DecodeStatus())
+
+	undecoded := Tx{blockdb.TxResult{Tx: []byte(`{}`), DecodeError: "message 0: unable to resolve type URL /foo.MsgBar"}}
+	require.Equal(t, "decode failed", undecoded.DecodeStatus())
+	require.Equal(t, "Decode error: message 0: unable to resolve type URL /foo.MsgBar\n\n{}", undecoded.Detail())
+}
+
+func TestTx_Messages(t *testing.T) {
+	t.Parallel()
+
+	tx := Tx{blockdb.TxResult{Tx: []byte(`{"body":{"messages":[{"@type":"/ibc.core.client.v1.MsgUpdateClient"},{"@type":"/ibc.core.channel.v1.MsgRecvPacket"}]}}`)}}
+	require.Equal(t, "/ibc.core.client.v1.MsgUpdateClient, /ibc.core.channel.v1.MsgRecvPacket", tx.Messages())
+
+	require.Empty(t, Tx{blockdb.TxResult{Tx: []byte(`not json`)}}.Messages())
+}
+
 func TestTxs_ToJSON(t *testing.T) {
 	t.Run("happy path", func(t *testing.T) {
 		txs := Txs{
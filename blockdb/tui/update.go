@@ -2,11 +2,14 @@ package tui
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
+	"github.com/strangelove-ventures/interchaintest/v8/blockdb"
 	"github.com/strangelove-ventures/interchaintest/v8/blockdb/tui/presenter"
 )
 
@@ -49,6 +52,151 @@ func (m *Model) Update(ctx context.Context) func(event *tcell.EventKey) *tcell.E
 			m.pushMainView(cosmosMessagesMain, cosmosMessagesView(tc, results))
 			return nil
 
+		case event.Rune() == 'b' && m.stack.Current() == testCasesMain:
+			// Show blocks.
+			tc := m.testCases[m.selectedRow()]
+			results, err := m.querySvc.Blocks(ctx, tc.ChainPKey)
+			if err != nil {
+				m.pushErrorModal(fmt.Errorf("query blocks: %w", err))
+				return nil
+			}
+			m.pushMainView(blocksMain, newBlocksView(tc, results))
+			return nil
+
+		case event.Rune() == 'p' && m.stack.Current() == testCasesMain:
+			// Show the IBC packets of all chains of the test case.
+			tc := m.testCases[m.selectedRow()]
+			results, err := m.querySvc.Packets(ctx, tc.ID)
+			if err != nil {
+				m.pushErrorModal(fmt.Errorf("query packets: %w", err))
+				return nil
+			}
+			m.pushMainView(packetsMain, packetsView(tc, results))
+			return nil
+
+		case event.Rune() == 'l' && m.stack.Current() == testCasesMain:
+			// Follow the blocks and txs of the chain.
+			tc := m.testCases[m.selectedRow()]
+			live := newLiveView(tc)
+			live.SetPaused(m.paused)
+			if err := m.appendLive(ctx, live); err != nil {
+				m.pushErrorModal(err)
+				return nil
+			}
+			m.live = live
+			m.syncRefresh()
+			m.pushMainView(liveMain, live)
+			return nil
+
+		case event.Key() == tcell.KeyRune && event.Rune() == ' ' && (m.stack.Current() == testCasesMain || m.stack.Current() == liveMain):
+			m.setPaused(!m.paused)
+			return nil
+
+		case event.Key() == tcell.KeyTab && m.stack.Current() == liveMain:
+			m.live.ToggleFocus()
+			return nil
+
+		case event.Key() == tcell.KeyEnter && m.stack.Current() == liveMain:
+			// Show block detail.
+			row, _ := m.live.Blocks.GetSelection()
+			if !m.live.Blocks.HasFocus() || row < 1 || row > len(m.live.BlockResults) {
+				return nil
+			}
+			block := m.live.BlockResults[row-1]
+			events, err := m.querySvc.BlockEvents(ctx, m.live.ChainPKey, block.Height)
+			if err != nil {
+				m.pushErrorModal(fmt.Errorf("query block events: %w", err))
+				return nil
+			}
+			m.pushMainView(blockDetailMain, blockDetailView(m.live.ChainID, block, events))
+			return nil
+
+		case event.Rune() == 's' && m.stack.Current() == testCasesMain:
+			m.pushMainView(sqlConsoleMain, newSQLConsoleView())
+			return nil
+
+		case event.Key() == tcell.KeyTab && m.stack.Current() == sqlConsoleMain:
+			m.sqlConsoleView().ToggleFocus()
+			return nil
+
+		case event.Key() == tcell.KeyEnter && m.stack.Current() == sqlConsoleMain:
+			console := m.sqlConsoleView()
+			if console.Input.HasFocus() {
+				m.runConsoleInput(ctx, console)
+				return nil
+			}
+			// Show row detail.
+			row, _ := console.Results.GetSelection()
+			if row < 1 || row > len(console.Result.Rows) {
+				return nil
+			}
+			m.pushMainView(sqlRowMain, sqlRowView(console.Result.Columns, console.Result.Rows[row-1], row))
+			return nil
+
+		case (event.Rune() == 'e' || event.Rune() == 'E') && m.exportableTable() != nil:
+			format := exportCSV
+			if event.Rune() == 'E' {
+				format = exportJSON
+			}
+			name := "blockdb-" + strings.TrimSuffix(m.stack.Current().String(), "Main")
+			path, err := exportTable(m.exportableTable(), m.exportDir, name, format)
+			if err != nil {
+				m.pushErrorModal(fmt.Errorf("export %s: %w", format, err))
+				return nil
+			}
+			m.pushMainView(messageModalMain, messageModalView("Exported to "+path))
+			return nil
+
+		case event.Rune() == 'c' && m.stack.Current() == testCasesMain:
+			// Mark the test case to compare, then compare it with the next one marked.
+			tc := m.testCases[m.selectedRow()]
+			table := m.testCasesTable
+			switch {
+			case m.compareWith == nil:
+				m.compareWith = &tc
+				table.SetTitle(fmt.Sprintf("Test Cases [comparing %d %s, press c on another run]", tc.ID, tc.Name))
+			case m.compareWith.ID == tc.ID:
+				m.compareWith = nil
+				table.SetTitle("Test Cases")
+			default:
+				a := *m.compareWith
+				results, err := m.querySvc.CompareTestCases(ctx, a.ID, tc.ID)
+				if err != nil {
+					m.pushErrorModal(fmt.Errorf("compare test cases: %w", err))
+					return nil
+				}
+				m.compareWith = nil
+				table.SetTitle("Test Cases")
+				m.pushMainView(compareMain, newCompareView(a, tc, results))
+			}
+			return nil
+
+		case event.Key() == tcell.KeyEnter && m.stack.Current() == compareMain:
+			// Show the block of both runs.
+			view := m.compareView()
+			row, _ := view.GetSelection()
+			if row < 1 || row > len(view.Comparisons) {
+				return nil
+			}
+			m.pushMainView(compareDetailMain, compareDetailView(view.Comparisons[row-1]))
+			return nil
+
+		case event.Key() == tcell.KeyEnter && m.stack.Current() == blocksMain:
+			// Show block detail.
+			blocks := m.blocksView()
+			row, _ := blocks.GetSelection()
+			if row < 1 || row > len(blocks.Blocks) {
+				return nil
+			}
+			block := blocks.Blocks[row-1]
+			events, err := m.querySvc.BlockEvents(ctx, blocks.ChainPKey, block.Height)
+			if err != nil {
+				m.pushErrorModal(fmt.Errorf("query block events: %w", err))
+				return nil
+			}
+			m.pushMainView(blockDetailMain, blockDetailView(blocks.ChainID, block, events))
+			return nil
+
 		case event.Rune() == '[' && m.stack.Current() == txDetailMain:
 			goToPrevPage(m.txDetailView().Pages)
 			return nil
@@ -95,6 +243,74 @@ func (m *Model) pushMainView(main mainContent, view tview.Primitive) {
 	m.mainContentView().AddAndSwitchToPage(main.String(), view, true)
 }
 
+// runConsoleInput runs the SQL query typed in the console,
+// or one of the dot-commands managing saved queries, named after those of the sqlite3 CLI.
+func (m *Model) runConsoleInput(ctx context.Context, console *sqlConsoleView) {
+	input := strings.TrimSpace(console.Input.GetText())
+	cmd, name, _ := strings.Cut(input, " ")
+	name = strings.TrimSpace(name)
+
+	switch cmd {
+	case ".saved":
+		saved, err := m.querySvc.SavedQueries(ctx)
+		if err != nil {
+			m.pushErrorModal(fmt.Errorf("query saved queries: %w", err))
+			return
+		}
+		res := blockdb.RawQueryResult{Columns: []string{"name", "query"}}
+		for _, s := range saved {
+			res.Rows = append(res.Rows, []any{s.Name, s.Query})
+		}
+		console.ShowResult(console.LastQuery, res)
+
+	case ".save":
+		if name == "" || console.LastQuery == "" {
+			m.pushErrorModal(errors.New("run a query, then save it with .save NAME"))
+			return
+		}
+		if err := m.querySvc.SaveQuery(ctx, name, console.LastQuery); err != nil {
+			m.pushErrorModal(err)
+			return
+		}
+		m.pushMainView(messageModalMain, messageModalView(fmt.Sprintf("Saved query %s", name)))
+
+	case ".delete":
+		if err := m.querySvc.DeleteSavedQuery(ctx, name); err != nil {
+			m.pushErrorModal(err)
+		}
+
+	case ".run":
+		saved, err := m.querySvc.SavedQueries(ctx)
+		if err != nil {
+			m.pushErrorModal(fmt.Errorf("query saved queries: %w", err))
+			return
+		}
+		for _, s := range saved {
+			if s.Name == name {
+				console.Input.SetText(s.Query)
+				m.runConsoleQuery(ctx, console, s.Query)
+				return
+			}
+		}
+		m.pushErrorModal(fmt.Errorf("no saved query named %s", name))
+
+	default:
+		m.runConsoleQuery(ctx, console, input)
+	}
+}
+
+// consoleRowLimit keeps the results table responsive for queries without a LIMIT clause.
+const consoleRowLimit = 1000
+
+func (m *Model) runConsoleQuery(ctx context.Context, console *sqlConsoleView, query string) {
+	res, err := m.querySvc.RawQuery(ctx, query, consoleRowLimit)
+	if err != nil {
+		m.pushErrorModal(fmt.Errorf("query: %w", err))
+		return
+	}
+	console.ShowResult(query, res)
+}
+
 func (m *Model) pushErrorModal(err error) {
 	m.pushMainView(errorModalMain, errorModalView(err))
 }
@@ -111,6 +327,42 @@ func (m *Model) txDetailView() *txDetailView {
 	return primitive.(*txDetailView)
 }
 
+func (m *Model) compareView() *compareView {
+	_, primitive := m.mainContentView().GetFrontPage()
+	return primitive.(*compareView)
+}
+
+func (m *Model) sqlConsoleView() *sqlConsoleView {
+	_, primitive := m.mainContentView().GetFrontPage()
+	return primitive.(*sqlConsoleView)
+}
+
+// exportableTable returns the table of the current main content, or nil if it is not a table.
+// The SQL console results are only exportable while the query is not being typed.
+func (m *Model) exportableTable() *tview.Table {
+	_, primitive := m.mainContentView().GetFrontPage()
+	switch view := primitive.(type) {
+	case *tview.Table:
+		return view
+	case *blocksView:
+		return view.Table
+	case *compareView:
+		return view.Table
+	case *liveView:
+		return view.FocusedTable()
+	case *sqlConsoleView:
+		if !view.Input.HasFocus() {
+			return view.Results
+		}
+	}
+	return nil
+}
+
+func (m *Model) blocksView() *blocksView {
+	_, primitive := m.mainContentView().GetFrontPage()
+	return primitive.(*blocksView)
+}
+
 // gotToNextPage assumes a convention where the page name is equal to its index. e.g. "0", "1", "2", etc.
 func gotToNextPage(pages *tview.Pages) {
 	idxStr, _ := pages.GetFrontPage()
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -29,10 +31,47 @@ func draw(view tview.Primitive) {
 }
 
 type mockQueryService struct {
-	GotChainPkey int64
-	Messages     []blockdb.CosmosMessageResult
-	Txs          []blockdb.TxResult
-	Err          error
+	GotChainPkey  int64
+	GotHeight     int64
+	GotAfter      int64
+	Recent        []blockdb.TestCaseResult
+	Messages      []blockdb.CosmosMessageResult
+	Txs           []blockdb.TxResult
+	BlockResults  []blockdb.BlockResult
+	Events        []blockdb.Event
+	PacketResults []blockdb.PacketResult
+	GotTestCase   int64
+	GotQuery      string
+	RawResult     blockdb.RawQueryResult
+	Saved         []blockdb.SavedQueryResult
+	GotCompare    [2]int64
+	Comparisons   []blockdb.BlockComparison
+	Err           error
+}
+
+func (m *mockQueryService) RecentTestCases(ctx context.Context, limit int) ([]blockdb.TestCaseResult, error) {
+	if ctx == nil {
+		panic("nil context")
+	}
+	return m.Recent, m.Err
+}
+
+func (m *mockQueryService) BlocksAfter(ctx context.Context, chainPkey int64, height int64) ([]blockdb.BlockResult, error) {
+	if ctx == nil {
+		panic("nil context")
+	}
+	m.GotChainPkey = chainPkey
+	m.GotAfter = height
+	return m.BlockResults, m.Err
+}
+
+func (m *mockQueryService) TransactionsAfter(ctx context.Context, chainPkey int64, height int64) ([]blockdb.TxResult, error) {
+	if ctx == nil {
+		panic("nil context")
+	}
+	m.GotChainPkey = chainPkey
+	m.GotAfter = height
+	return m.Txs, m.Err
 }
 
 func (m *mockQueryService) Transactions(ctx context.Context, chainPkey int64) ([]blockdb.TxResult, error) {
@@ -51,6 +90,69 @@ func (m *mockQueryService) CosmosMessages(ctx context.Context, chainPkey int64)
 	return m.Messages, m.Err
 }
 
+func (m *mockQueryService) Blocks(ctx context.Context, chainPkey int64) ([]blockdb.BlockResult, error) {
+	if ctx == nil {
+		panic("nil context")
+	}
+	m.GotChainPkey = chainPkey
+	return m.BlockResults, m.Err
+}
+
+func (m *mockQueryService) BlockEvents(ctx context.Context, chainPkey int64, height int64) ([]blockdb.Event, error) {
+	if ctx == nil {
+		panic("nil context")
+	}
+	m.GotChainPkey = chainPkey
+	m.GotHeight = height
+	return m.Events, m.Err
+}
+
+func (m *mockQueryService) Packets(ctx context.Context, testCaseID int64) ([]blockdb.PacketResult, error) {
+	if ctx == nil {
+		panic("nil context")
+	}
+	m.GotTestCase = testCaseID
+	return m.PacketResults, m.Err
+}
+
+func (m *mockQueryService) RawQuery(ctx context.Context, query string, limit int) (blockdb.RawQueryResult, error) {
+	if ctx == nil {
+		panic("nil context")
+	}
+	m.GotQuery = query
+	return m.RawResult, m.Err
+}
+
+func (m *mockQueryService) SavedQueries(ctx context.Context) ([]blockdb.SavedQueryResult, error) {
+	if ctx == nil {
+		panic("nil context")
+	}
+	return m.Saved, m.Err
+}
+
+func (m *mockQueryService) SaveQuery(ctx context.Context, name, query string) error {
+	if ctx == nil {
+		panic("nil context")
+	}
+	m.Saved = append(m.Saved, blockdb.SavedQueryResult{Name: name, Query: query})
+	return m.Err
+}
+
+func (m *mockQueryService) DeleteSavedQuery(ctx context.Context, name string) error {
+	if ctx == nil {
+		panic("nil context")
+	}
+	return m.Err
+}
+
+func (m *mockQueryService) CompareTestCases(ctx context.Context, testCaseA, testCaseB int64) ([]blockdb.BlockComparison, error) {
+	if ctx == nil {
+		panic("nil context")
+	}
+	m.GotCompare = [2]int64{testCaseA, testCaseB}
+	return m.Comparisons, m.Err
+}
+
 func TestModel_Update(t *testing.T) {
 	ctx := context.Background()
 
@@ -95,12 +197,316 @@ func TestModel_Update(t *testing.T) {
 		require.Contains(t, table.(*tview.Table).GetTitle(), "my-chain1")
 	})
 
+	t.Run("blocks view", func(t *testing.T) {
+		querySvc := &mockQueryService{
+			BlockResults: []blockdb.BlockResult{
+				{Height: 10, TxTotal: 1},
+				{Height: 11, TxTotal: 2, FailedTxTotal: 1},
+			},
+			Events: []blockdb.Event{{Type: "timeout_packet"}},
+		}
+		model := NewModel(querySvc, "", "", time.Now(), []blockdb.TestCaseResult{
+			{ChainPKey: 5, ChainID: "my-chain1"},
+		})
+
+		draw(model.RootView())
+
+		update := model.Update(ctx)
+		update(runeKey('b'))
+
+		require.EqualValues(t, 5, querySvc.GotChainPkey)
+		require.Equal(t, 2, model.mainContentView().GetPageCount())
+
+		blocks := model.blocksView()
+		// 3 rows: 1 header + 2 blockdb.BlockResult
+		require.Equal(t, 3, blocks.GetRowCount())
+		require.Contains(t, blocks.GetTitle(), "my-chain1")
+		require.Equal(t, "2 (1 failed)", blocks.GetCell(2, 4).Text)
+
+		// By default, first row is selected in a rendered table.
+		draw(model.RootView())
+		update(enterKey)
+
+		require.Equal(t, 3, model.mainContentView().GetPageCount())
+		_, primitive := model.mainContentView().GetFrontPage()
+		textView := primitive.(*tview.TextView)
+		require.Contains(t, textView.GetTitle(), "my-chain1 @ Height 10")
+		require.Contains(t, textView.GetText(true), "timeout_packet")
+		require.EqualValues(t, 10, querySvc.GotHeight)
+	})
+
+	t.Run("packets view", func(t *testing.T) {
+		querySvc := &mockQueryService{
+			PacketResults: []blockdb.PacketResult{
+				{SrcChainID: "chain-a", SrcPort: "transfer", SrcChannel: "channel-0", Sequence: 1},
+				{SrcChainID: "chain-b", SrcPort: "transfer", SrcChannel: "channel-0", Sequence: 1},
+			},
+		}
+		model := NewModel(querySvc, "", "", time.Now(), []blockdb.TestCaseResult{
+			{ID: 3, Name: "TestIBC", ChainPKey: 5, ChainID: "my-chain1"},
+		})
+
+		draw(model.RootView())
+
+		update := model.Update(ctx)
+		update(runeKey('p'))
+
+		require.EqualValues(t, 3, querySvc.GotTestCase)
+		require.Equal(t, 2, model.mainContentView().GetPageCount())
+
+		_, primitive := model.mainContentView().GetFrontPage()
+		table := primitive.(*tview.Table)
+		// 3 rows: 1 header + 2 blockdb.PacketResult
+		require.Equal(t, 3, table.GetRowCount())
+		require.Contains(t, table.GetTitle(), "TestIBC")
+		require.Equal(t, "chain-b transfer/channel-0", table.GetCell(2, 0).Text)
+		require.Equal(t, "awaiting recv_packet", table.GetCell(2, 6).Text)
+	})
+
+	t.Run("compare view", func(t *testing.T) {
+		querySvc := &mockQueryService{
+			Comparisons: []blockdb.BlockComparison{
+				{ChainID: "chain-a", Height: 1, A: &blockdb.BlockSummary{TxTotal: 1}, B: &blockdb.BlockSummary{TxTotal: 1}},
+				{ChainID: "chain-a", Height: 2, A: &blockdb.BlockSummary{TxTotal: 1}, B: &blockdb.BlockSummary{TxTotal: 2}},
+				{ChainID: "chain-a", Height: 3, B: &blockdb.BlockSummary{}},
+			},
+		}
+		model := NewModel(querySvc, "", "", time.Now(), []blockdb.TestCaseResult{
+			{ID: 1, Name: "TestIBC", GitSha: "abc"},
+			{ID: 2, Name: "TestIBC", GitSha: "def"},
+		})
+
+		draw(model.RootView())
+
+		update := model.Update(ctx)
+		update(runeKey('c'))
+		table := model.testCasesTable
+		require.Contains(t, table.GetTitle(), "comparing 1")
+
+		// Marking the same run again unmarks it.
+		update(runeKey('c'))
+		require.Equal(t, "Test Cases", table.GetTitle())
+
+		update(runeKey('c'))
+		table.Select(2, 0)
+		update(runeKey('c'))
+
+		require.Equal(t, [2]int64{1, 2}, querySvc.GotCompare)
+		require.Equal(t, 2, model.mainContentView().GetPageCount())
+		require.Equal(t, "Test Cases", table.GetTitle())
+
+		view := model.compareView()
+		// 4 rows: 1 header + 3 blockdb.BlockComparison
+		require.Equal(t, 4, view.GetRowCount())
+		require.Contains(t, view.GetTitle(), "A: 1 TestIBC [abc] | B: 2 TestIBC [def]")
+		require.Equal(t, "1 | 2", view.GetCell(2, 2).Text)
+		require.Equal(t, errorTextColor, view.GetCell(2, 2).Color)
+		require.Equal(t, textColor, view.GetCell(2, 3).Color)
+		require.Equal(t, "missing", view.GetCell(3, 7).Text)
+
+		draw(model.RootView())
+		view.Select(2, 0)
+		update(enterKey)
+
+		require.Equal(t, 3, model.mainContentView().GetPageCount())
+		_, primitive := model.mainContentView().GetFrontPage()
+		textView := primitive.(*tview.TextView)
+		require.Equal(t, "chain-a @ Height 2", textView.GetTitle())
+		require.Contains(t, textView.GetText(true), "Differences: txs")
+	})
+
+	t.Run("live view", func(t *testing.T) {
+		querySvc := &mockQueryService{
+			BlockResults: []blockdb.BlockResult{{Height: 10, TxTotal: 1}},
+			Txs:          []blockdb.TxResult{{Height: 10, Tx: []byte(`{}`)}},
+		}
+		tc := blockdb.TestCaseResult{ID: 1, ChainPKey: 5, ChainID: "my-chain1"}
+		model := NewModel(querySvc, "", "", time.Now(), []blockdb.TestCaseResult{tc})
+
+		draw(model.RootView())
+
+		update := model.Update(ctx)
+		update(runeKey('l'))
+
+		require.Equal(t, 2, model.mainContentView().GetPageCount())
+		require.EqualValues(t, -1, querySvc.GotAfter)
+		live := model.live
+		require.Equal(t, "my-chain1 Blocks [live]", live.Blocks.GetTitle())
+		require.Equal(t, 2, live.Blocks.GetRowCount())
+		require.Equal(t, 2, live.Txs.GetRowCount())
+
+		querySvc.Recent = []blockdb.TestCaseResult{tc}
+		querySvc.BlockResults = []blockdb.BlockResult{
+			{Height: 11},
+			{Height: 12, TxTotal: 1, FailedTxTotal: 1},
+		}
+		querySvc.Txs = []blockdb.TxResult{
+			{Height: 12, Code: 5, Codespace: "sdk"},
+			// Saved after the blocks were queried.
+			{Height: 13},
+		}
+		show := model.Refresh(ctx)
+		require.EqualValues(t, 10, querySvc.GotAfter)
+		require.Equal(t, 2, live.Blocks.GetRowCount(), "shown on the main goroutine")
+		show()
+
+		require.EqualValues(t, 12, live.Height)
+		require.Equal(t, 4, live.Blocks.GetRowCount())
+		require.Equal(t, errorTextColor, live.Blocks.GetCell(3, 3).Color)
+		row, _ := live.Blocks.GetSelection()
+		require.Equal(t, 3, row, "follows the new blocks")
+
+		require.Equal(t, 3, live.Txs.GetRowCount())
+		require.Equal(t, "failed with code 5 (sdk)", live.Txs.GetCell(2, 1).Text)
+		require.Equal(t, errorTextColor, live.Txs.GetCell(2, 0).Color)
+
+		// Paused, the view is not refreshed.
+		update(runeKey(' '))
+		require.Equal(t, "my-chain1 Blocks [paused]", live.Blocks.GetTitle())
+		querySvc.BlockResults = []blockdb.BlockResult{{Height: 13}}
+		model.Refresh(ctx)()
+		require.Equal(t, 4, live.Blocks.GetRowCount())
+
+		update(runeKey(' '))
+		model.Refresh(ctx)()
+		require.Equal(t, 5, live.Blocks.GetRowCount())
+
+		// Paused while querying, the view is not refreshed either.
+		querySvc.BlockResults = []blockdb.BlockResult{{Height: 14}}
+		show = model.Refresh(ctx)
+		update(runeKey(' '))
+		show()
+		require.Equal(t, 5, live.Blocks.GetRowCount())
+		update(runeKey(' '))
+
+		update(tcell.NewEventKey(tcell.KeyTab, 0, 0))
+		require.Same(t, live.Txs, model.exportableTable())
+
+		update(tcell.NewEventKey(tcell.KeyTab, 0, 0))
+		update(enterKey)
+		require.Equal(t, 3, model.mainContentView().GetPageCount())
+		_, primitive := model.mainContentView().GetFrontPage()
+		require.Equal(t, "my-chain1 @ Height 13", primitive.(*tview.TextView).GetTitle())
+	})
+
+	t.Run("refresh test cases", func(t *testing.T) {
+		querySvc := &mockQueryService{}
+		model := NewModel(querySvc, "", "", time.Now(), []blockdb.TestCaseResult{
+			{ID: 1, ChainPKey: 1, ChainID: "chain-a"},
+			{ID: 1, ChainPKey: 2, ChainID: "chain-b"},
+		})
+
+		draw(model.RootView())
+		model.testCasesTable.Select(2, 0)
+
+		querySvc.Recent = []blockdb.TestCaseResult{
+			{ID: 2, ChainPKey: 3, ChainID: "chain-a"},
+			{ID: 1, ChainPKey: 1, ChainID: "chain-a"},
+			{ID: 1, ChainPKey: 2, ChainID: "chain-b"},
+		}
+		model.Refresh(ctx)()
+
+		require.Equal(t, 4, model.testCasesTable.GetRowCount())
+		row, _ := model.testCasesTable.GetSelection()
+		require.Equal(t, 3, row)
+		require.Equal(t, "chain-b", model.testCases[model.selectedRow()].ChainID)
+
+		querySvc.Err = errors.New("boom")
+		model.Refresh(ctx)()
+		require.True(t, model.paused)
+		require.Equal(t, errorModalMain, model.stack.Current())
+	})
+
+	t.Run("sql console", func(t *testing.T) {
+		querySvc := &mockQueryService{
+			RawResult: blockdb.RawQueryResult{
+				Columns: []string{"tx_id", "tx"},
+				Rows: [][]any{
+					{int64(1), "{\n  \"memo\": \"hi\"\n}"},
+					{int64(2), nil},
+				},
+			},
+		}
+		model := NewModel(querySvc, "", "", time.Now(), []blockdb.TestCaseResult{{ChainPKey: 5}})
+		model.exportDir = t.TempDir()
+
+		draw(model.RootView())
+
+		update := model.Update(ctx)
+		update(runeKey('s'))
+		require.Equal(t, 2, model.mainContentView().GetPageCount())
+
+		console := model.sqlConsoleView()
+		require.True(t, console.Input.HasFocus())
+
+		// Keys are typed in the query while it has focus.
+		require.NotNil(t, update(runeKey('e')))
+
+		console.Input.SetText("SELECT tx_id, tx FROM v_tx_flattened")
+		update(enterKey)
+		require.Equal(t, "SELECT tx_id, tx FROM v_tx_flattened", querySvc.GotQuery)
+		require.Equal(t, 3, console.Results.GetRowCount())
+		require.Equal(t, `{"memo":"hi"}`, console.Results.GetCell(1, 1).Text)
+		require.Equal(t, "NULL", console.Results.GetCell(2, 1).Text)
+
+		console.Input.SetText(".save memos")
+		update(enterKey)
+		require.Equal(t, []blockdb.SavedQueryResult{{Name: "memos", Query: "SELECT tx_id, tx FROM v_tx_flattened"}}, querySvc.Saved)
+		update(escKey)
+
+		update(tcell.NewEventKey(tcell.KeyTab, ' ', 0))
+		require.True(t, console.Results.HasFocus())
+
+		// Export the raw values, not the compacted JSON.
+		update(runeKey('E'))
+		_, primitive := model.mainContentView().GetFrontPage()
+		require.IsType(t, &tview.Flex{}, primitive)
+		files, err := filepath.Glob(filepath.Join(model.exportDir, "blockdb-sqlConsole-*.json"))
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+		b, err := os.ReadFile(files[0])
+		require.NoError(t, err)
+		var exported []map[string]any
+		require.NoError(t, json.Unmarshal(b, &exported))
+		require.Equal(t, []map[string]any{
+			{"tx_id": float64(1), "tx": "{\n  \"memo\": \"hi\"\n}"},
+			{"tx_id": float64(2), "tx": nil},
+		}, exported)
+		update(escKey)
+
+		// By default, first row is selected in a rendered table.
+		draw(model.RootView())
+		update(enterKey)
+		_, primitive = model.mainContentView().GetFrontPage()
+		textView := primitive.(*tview.TextView)
+		require.Equal(t, "Row 1", textView.GetTitle())
+		require.Contains(t, textView.GetText(true), "tx:\n{\n  \"memo\": \"hi\"\n}")
+	})
+
+	t.Run("export csv", func(t *testing.T) {
+		model := NewModel(&mockQueryService{}, "", "", time.Now(), []blockdb.TestCaseResult{
+			{ID: 1, Name: "TestA", ChainID: "chain-a"},
+		})
+		model.exportDir = t.TempDir()
+
+		update := model.Update(ctx)
+		update(runeKey('e'))
+
+		files, err := filepath.Glob(filepath.Join(model.exportDir, "blockdb-testCases-*.csv"))
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+		b, err := os.ReadFile(files[0])
+		require.NoError(t, err)
+		require.Contains(t, string(b), "ID,Date,Name,Git Sha,Chain,Height,Tx Total\n1,")
+		require.Contains(t, string(b), "TestA")
+	})
+
 	t.Run("tx detail", func(t *testing.T) {
 		querySvc := &mockQueryService{
 			Txs: []blockdb.TxResult{
 				{Height: 12, Tx: []byte(`{"tx":1}`)},
 				{Height: 13, Tx: []byte(`{"tx":2}`)},
-				{Height: 14, Tx: []byte(`{"tx":3}`)},
+				{Height: 14, Tx: []byte(`{"tx":3}`), DecodeError: "message 0: unable to resolve type URL /foo.MsgBar"},
 			},
 		}
 		model := NewModel(querySvc, "", "", time.Now(), []blockdb.TestCaseResult{
@@ -157,6 +563,8 @@ func TestModel_Update(t *testing.T) {
 		textView = primitive.(*tview.TextView)
 
 		require.Contains(t, textView.GetTitle(), "Tx 3 of 3")
+		require.Contains(t, textView.GetTitle(), "[decode failed]")
+		require.Contains(t, textView.GetText(true), "Decode error: message 0: unable to resolve type URL /foo.MsgBar")
 
 		// Move back to the previous page. Assert does not retreat past first page.
 		update(runeKey('['))
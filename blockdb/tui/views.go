@@ -63,33 +63,32 @@ func detailTableView(title string, headers []string, rows [][]string) *tview.Tab
 	tbl.SetTitle(title)
 
 	headerCell := func(s string) *tview.TableCell {
-		s = strings.ToUpper(s)
-		return tview.NewTableCell(s).
+		return tview.NewTableCell(strings.ToUpper(s)).
 			SetStyle(textStyle.Bold(true)).
 			SetExpansion(1).
-			SetSelectable(false)
+			SetSelectable(false).
+			SetReference(cellRef{s}) // Export the header as written.
 	}
 
 	for col, header := range headers {
 		tbl.SetCell(0, col, headerCell(header))
 	}
 
-	contentCell := func(s string) *tview.TableCell {
-		return tview.NewTableCell(s).SetStyle(textStyle).SetExpansion(1)
+	for _, row := range rows {
+		appendTableRow(tbl, row)
 	}
+	return tbl
+}
 
-	for i, row := range rows {
-		rowPos := i + 1 // 1 offsets header row
-
-		if len(row) != len(headers) {
-			panic(fmt.Errorf("row %v column count %d must equal header count %d", row, len(row), len(headers)))
-		}
-
-		for col, content := range row {
-			tbl.SetCell(rowPos, col, contentCell(content))
-		}
+// appendTableRow adds a row below the last one of a table built by detailTableView.
+func appendTableRow(tbl *tview.Table, row []string) {
+	if len(row) != tbl.GetColumnCount() {
+		panic(fmt.Errorf("row %v column count %d must equal header count %d", row, len(row), tbl.GetColumnCount()))
+	}
+	rowPos := tbl.GetRowCount()
+	for col, content := range row {
+		tbl.SetCell(rowPos, col, tview.NewTableCell(content).SetStyle(textStyle).SetExpansion(1))
 	}
-	return tbl
 }
 
 // testCasesView is the initial main content.
@@ -104,8 +103,12 @@ func testCasesView(m *Model) *tview.Table {
 		"Tx Total",
 	}
 
-	rows := make([][]string, len(m.testCases))
-	for i, tc := range m.testCases {
+	return detailTableView("Test Cases", headers, testCaseRows(m.testCases))
+}
+
+func testCaseRows(testCases []blockdb.TestCaseResult) [][]string {
+	rows := make([][]string, len(testCases))
+	for i, tc := range testCases {
 		pres := presenter.TestCase{Result: tc}
 		rows[i] = []string{
 			pres.ID(),
@@ -117,8 +120,7 @@ func testCasesView(m *Model) *tview.Table {
 			pres.TxTotal(),
 		}
 	}
-
-	return detailTableView("Test Cases", headers, rows)
+	return rows
 }
 
 func cosmosMessagesView(tc blockdb.TestCaseResult, msgs []blockdb.CosmosMessageResult) *tview.Table {
@@ -150,10 +152,426 @@ func cosmosMessagesView(tc blockdb.TestCaseResult, msgs []blockdb.CosmosMessageR
 	return detailTableView(title, headers, rows)
 }
 
+// blocksView is a table of blocks, which keeps the blocks to show the detail of the selected one.
+type blocksView struct {
+	*tview.Table
+
+	ChainPKey int64
+	ChainID   string
+	Blocks    []blockdb.BlockResult
+}
+
+func newBlocksView(tc blockdb.TestCaseResult, blocks []blockdb.BlockResult) *blocksView {
+	headers := []string{
+		"Height",
+		"Time",
+		"Proposer",
+		"App Hash",
+		"Txs",
+		"Gas Used/Wanted",
+		"Events",
+	}
+
+	rows := make([][]string, len(blocks))
+	for i, block := range blocks {
+		pres := presenter.Block{Result: block}
+		rows[i] = []string{
+			pres.Height(),
+			pres.Time(),
+			pres.Proposer(),
+			pres.AppHash(),
+			pres.Txs(),
+			pres.Gas(),
+			pres.Events(),
+		}
+	}
+
+	title := fmt.Sprintf("%s Blocks [%s]", tc.ChainID, presenter.FormatTime(tc.CreatedAt))
+	return &blocksView{
+		Table:     detailTableView(title, headers, rows),
+		ChainPKey: tc.ChainPKey,
+		ChainID:   tc.ChainID,
+		Blocks:    blocks,
+	}
+}
+
+func blockDetailView(chainID string, block blockdb.BlockResult, events []blockdb.Event) *tview.TextView {
+	pres := presenter.Block{Result: block}
+	textView := tview.NewTextView().
+		SetText(pres.Detail(events)).
+		SetTextColor(textColor).
+		SetWrap(true).
+		SetWordWrap(true).
+		SetTextAlign(tview.AlignLeft).
+		SetScrollable(true)
+
+	textView.SetBorder(true).
+		SetBorderPadding(0, 0, 1, 1).
+		SetBorderAttributes(tcell.AttrDim)
+
+	textView.SetTitle(fmt.Sprintf("%s @ Height %d", chainID, block.Height))
+	return textView
+}
+
+func packetsView(tc blockdb.TestCaseResult, packets []blockdb.PacketResult) *tview.Table {
+	headers := []string{
+		"Source",
+		"Destination",
+		"Sequence",
+		"Sent",
+		"Recv",
+		"Ack",
+		"Status",
+	}
+
+	rows := make([][]string, len(packets))
+	for i, packet := range packets {
+		pres := presenter.Packet{Result: packet}
+		rows[i] = []string{
+			pres.Source(),
+			pres.Destination(),
+			pres.Sequence(),
+			pres.Sent(),
+			pres.Recv(),
+			pres.Ack(),
+			pres.Status(),
+		}
+	}
+
+	title := fmt.Sprintf("%s Packets [%s]", tc.Name, presenter.FormatTime(tc.CreatedAt))
+	return detailTableView(title, headers, rows)
+}
+
+// liveRowLimit is the number of rows kept by each table of a liveView, dropping the oldest ones.
+const liveRowLimit = 1000
+
+// liveView follows the blocks and txs of a chain as they are saved, with failed txs highlighted.
+type liveView struct {
+	*tview.Flex
+
+	Blocks *tview.Table
+	Txs    *tview.Table
+
+	ChainPKey int64
+	ChainID   string
+	// Height of the last block shown.
+	Height int64
+	// BlockResults are the blocks shown, in the order of the rows of Blocks.
+	BlockResults []blockdb.BlockResult
+}
+
+func newLiveView(tc blockdb.TestCaseResult) *liveView {
+	live := &liveView{
+		Blocks: detailTableView("", []string{
+			"Height",
+			"Time",
+			"Proposer",
+			"Txs",
+			"Gas Used/Wanted",
+			"Events",
+		}, nil),
+		Txs: detailTableView("", []string{
+			"Height",
+			"Status",
+			"Gas Used/Wanted",
+			"Messages",
+		}, nil),
+		ChainPKey: tc.ChainPKey,
+		ChainID:   tc.ChainID,
+		Height:    -1,
+	}
+	live.SetPaused(false)
+
+	live.Flex = tview.NewFlex().SetDirection(tview.FlexRow)
+	live.Flex.SetBorder(false)
+	live.Flex.AddItem(live.Blocks, 0, 1, true)
+	live.Flex.AddItem(live.Txs, 0, 1, false)
+
+	live.Blocks.Focus(nil)
+	return live
+}
+
+// SetPaused shows whether the view is following the chain.
+func (live *liveView) SetPaused(paused bool) {
+	state := "live"
+	if paused {
+		state = "paused"
+	}
+	live.Blocks.SetTitle(fmt.Sprintf("%s Blocks [%s]", live.ChainID, state))
+	live.Txs.SetTitle(fmt.Sprintf("%s Txs [%s]", live.ChainID, state))
+}
+
+// ToggleFocus switches between the blocks and the txs.
+func (live *liveView) ToggleFocus() {
+	if live.Blocks.HasFocus() {
+		live.Blocks.Blur()
+		live.Txs.Focus(nil)
+		return
+	}
+	live.Txs.Blur()
+	live.Blocks.Focus(nil)
+}
+
+// FocusedTable is the table navigated by the user.
+func (live *liveView) FocusedTable() *tview.Table {
+	if live.Txs.HasFocus() {
+		return live.Txs
+	}
+	return live.Blocks
+}
+
+// Append adds blocks and txs saved since the last call. A table whose last row is selected keeps following
+// the new rows, otherwise its selection is left alone so that the user can browse older rows.
+func (live *liveView) Append(blocks []blockdb.BlockResult, txs []blockdb.TxResult) {
+	blocksFollowing, txsFollowing := isLastRowSelected(live.Blocks), isLastRowSelected(live.Txs)
+
+	for _, block := range blocks {
+		pres := presenter.Block{Result: block}
+		appendTableRow(live.Blocks, []string{
+			pres.Height(),
+			pres.Time(),
+			pres.Proposer(),
+			pres.Txs(),
+			pres.Gas(),
+			pres.Events(),
+		})
+		if block.FailedTxTotal > 0 {
+			live.Blocks.GetCell(live.Blocks.GetRowCount()-1, 3).SetTextColor(errorTextColor)
+		}
+		live.BlockResults = append(live.BlockResults, block)
+		live.Height = max(live.Height, block.Height)
+	}
+	for _, tx := range txs {
+		pres := presenter.Tx{Result: tx}
+		msgs := pres.Messages()
+		if status := pres.DecodeStatus(); status != "" {
+			msgs = strings.TrimSpace(msgs + " (" + status + ")")
+		}
+		appendTableRow(live.Txs, []string{
+			pres.Height(),
+			pres.Status(),
+			pres.Gas(),
+			msgs,
+		})
+		row := live.Txs.GetRowCount() - 1
+		if tx.Code != 0 {
+			for col := 0; col < live.Txs.GetColumnCount(); col++ {
+				live.Txs.GetCell(row, col).SetTextColor(errorTextColor)
+			}
+		}
+		if tx.DecodeError != "" {
+			live.Txs.GetCell(row, 3).SetTextColor(errorTextColor)
+		}
+	}
+
+	// Row 1 is the oldest row, below the header.
+	for live.Blocks.GetRowCount()-1 > liveRowLimit {
+		live.Blocks.RemoveRow(1)
+		live.BlockResults = live.BlockResults[1:]
+	}
+	for live.Txs.GetRowCount()-1 > liveRowLimit {
+		live.Txs.RemoveRow(1)
+	}
+
+	if blocksFollowing {
+		live.Blocks.Select(live.Blocks.GetRowCount()-1, 0)
+	}
+	if txsFollowing {
+		live.Txs.Select(live.Txs.GetRowCount()-1, 0)
+	}
+}
+
+// isLastRowSelected is also true for a table without rows, so that it follows its first rows.
+func isLastRowSelected(tbl *tview.Table) bool {
+	row, _ := tbl.GetSelection()
+	return row <= 0 || row == tbl.GetRowCount()-1
+}
+
+// compareView is a table aligning the blocks of two test runs, with the differences highlighted.
+type compareView struct {
+	*tview.Table
+
+	Comparisons []blockdb.BlockComparison
+}
+
+func newCompareView(a, b blockdb.TestCaseResult, comparisons []blockdb.BlockComparison) *compareView {
+	headers := []string{
+		"Chain",
+		"Height",
+		"Txs",
+		"Failures",
+		"Messages",
+		"Events",
+		"Gas Used",
+		"Differences",
+	}
+	// Columns highlighted for each difference.
+	diffColumns := map[string][]int{
+		blockdb.DiffMissing:  {0, 1},
+		blockdb.DiffTxs:      {2},
+		blockdb.DiffFailures: {3},
+		blockdb.DiffMessages: {4},
+		blockdb.DiffEvents:   {5},
+		blockdb.DiffGas:      {6},
+	}
+
+	rows := make([][]string, len(comparisons))
+	for i, c := range comparisons {
+		pres := presenter.BlockComparison{Result: c}
+		rows[i] = []string{
+			pres.Chain(),
+			pres.Height(),
+			pres.Txs(),
+			pres.Failures(),
+			pres.Messages(),
+			pres.Events(),
+			pres.Gas(),
+			pres.Differences(),
+		}
+	}
+
+	title := fmt.Sprintf("A: %d %s [%s] | B: %d %s [%s]", a.ID, a.Name, a.GitSha, b.ID, b.Name, b.GitSha)
+	tbl := detailTableView(title, headers, rows)
+	for i, c := range comparisons {
+		diffs := c.Differences()
+		if len(diffs) > 0 {
+			tbl.GetCell(i+1, len(headers)-1).SetTextColor(errorTextColor)
+		}
+		for _, diff := range diffs {
+			for _, col := range diffColumns[diff] {
+				tbl.GetCell(i+1, col).SetTextColor(errorTextColor)
+			}
+		}
+	}
+	return &compareView{Table: tbl, Comparisons: comparisons}
+}
+
+func compareDetailView(c blockdb.BlockComparison) *tview.TextView {
+	pres := presenter.BlockComparison{Result: c}
+	textView := tview.NewTextView().
+		SetText(pres.Detail()).
+		SetTextColor(textColor).
+		SetWrap(true).
+		SetWordWrap(true).
+		SetTextAlign(tview.AlignLeft).
+		SetScrollable(true)
+
+	textView.SetBorder(true).
+		SetBorderPadding(0, 0, 1, 1).
+		SetBorderAttributes(tcell.AttrDim)
+
+	textView.SetTitle(fmt.Sprintf("%s @ Height %d", c.ChainID, c.Height))
+	return textView
+}
+
+// sqlConsoleView runs read-only SQL queries typed by the user against the database and shows the results.
+type sqlConsoleView struct {
+	*tview.Flex
+
+	Input   *tview.InputField
+	Results *tview.Table
+
+	// Result of the last query, and the query itself so that it can be saved.
+	Result    blockdb.RawQueryResult
+	LastQuery string
+}
+
+func newSQLConsoleView() *sqlConsoleView {
+	console := &sqlConsoleView{
+		Input: tview.NewInputField().
+			SetPlaceholder("SELECT * FROM v_tx_flattened LIMIT 10").
+			SetFieldTextColor(searchActiveColor).
+			SetFieldBackgroundColor(backgroundColor),
+		Results: detailTableView("Results", []string{"Results"}, nil),
+	}
+	console.Input.SetTitle("SQL (read-only)").
+		SetTitleAlign(tview.AlignLeft).
+		SetBorder(true).
+		SetBorderAttributes(tcell.AttrDim)
+
+	console.Flex = tview.NewFlex().SetDirection(tview.FlexRow)
+	console.Flex.SetBorder(false)
+	console.Flex.AddItem(console.Input, 3, 1, true)
+	console.Flex.AddItem(console.Results, 0, 9, false)
+
+	console.Input.Focus(nil)
+	return console
+}
+
+// ToggleFocus switches between typing a query and navigating its results.
+func (console *sqlConsoleView) ToggleFocus() {
+	if console.Input.HasFocus() {
+		console.Input.Blur()
+		console.Results.Focus(nil)
+		return
+	}
+	console.Results.Blur()
+	console.Input.Focus(nil)
+}
+
+// ShowResult replaces the results table. Cells show JSON compacted to one line, but export the raw values.
+func (console *sqlConsoleView) ShowResult(query string, res blockdb.RawQueryResult) {
+	console.LastQuery = query
+	console.Result = res
+
+	headers := res.Columns
+	if len(headers) == 0 {
+		// E.g. for a PRAGMA without result.
+		headers = []string{"Results"}
+	}
+	rows := make([][]string, len(res.Rows))
+	for i, row := range res.Rows {
+		rows[i] = make([]string, len(row))
+		for col, v := range row {
+			rows[i][col] = presenter.SQLValue(v)
+		}
+	}
+
+	title := fmt.Sprintf("Results (%d rows)", len(res.Rows))
+	if res.Truncated {
+		title = fmt.Sprintf("Results (first %d rows)", len(res.Rows))
+	}
+	tbl := detailTableView(title, headers, rows)
+	for i, row := range res.Rows {
+		for col, v := range row {
+			tbl.GetCell(i+1, col).SetReference(cellRef{v})
+		}
+	}
+
+	console.Flex.RemoveItem(console.Results)
+	console.Results = tbl
+	console.Flex.AddItem(console.Results, 0, 9, false)
+}
+
+func sqlRowView(columns []string, values []any, row int) *tview.TextView {
+	textView := tview.NewTextView().
+		SetText(presenter.SQLRow(columns, values)).
+		SetTextColor(textColor).
+		SetWrap(true).
+		SetWordWrap(true).
+		SetTextAlign(tview.AlignLeft).
+		SetScrollable(true)
+
+	textView.SetBorder(true).
+		SetBorderPadding(0, 0, 1, 1).
+		SetBorderAttributes(tcell.AttrDim)
+
+	textView.SetTitle(fmt.Sprintf("Row %d", row))
+	return textView
+}
+
 func errorModalView(err error) *tview.Flex {
+	return modalView(fmt.Sprintf("Error: %v", err), errorTextColor)
+}
+
+func messageModalView(msg string) *tview.Flex {
+	return modalView(msg, textColor)
+}
+
+func modalView(text string, color tcell.Color) *tview.Flex {
 	modal := tview.NewModal().
-		SetText(fmt.Sprintf("Error: %v", err)).
-		SetTextColor(errorTextColor).
+		SetText(text).
+		SetTextColor(color).
 		SetBackgroundColor(backgroundColor)
 
 	// Flex centers the modal. See: https://github.com/rivo/tview/wiki/Modal
@@ -242,7 +660,7 @@ func (detail *txDetailView) replacePages(searchTerm, pageIdx string) {
 		detail.Pages.RemovePage(idx)
 
 		pres := presenter.Tx{Result: tx}
-		text, regions := highlight.Text(pres.Data())
+		text, regions := highlight.Text(pres.Detail())
 		textView := tview.NewTextView().
 			SetText(text).
 			SetTextColor(textColor).
@@ -259,7 +677,12 @@ func (detail *txDetailView) replacePages(searchTerm, pageIdx string) {
 			SetBorderPadding(0, 0, 1, 1).
 			SetBorderAttributes(tcell.AttrDim)
 
-		textView.SetTitle(fmt.Sprintf("%s @ Height %d [Tx %d of %d]", detail.chainID, tx.Height, i+1, len(detail.Txs)))
+		title := fmt.Sprintf("%s @ Height %d [Tx %d of %d] [%s, gas %s]", detail.chainID, tx.Height, i+1, len(detail.Txs), pres.Status(), pres.Gas())
+		if status := pres.DecodeStatus(); status != "" {
+			title += " [" + status + "]"
+			textView.SetTitleColor(errorTextColor)
+		}
+		textView.SetTitle(title)
 
 		detail.Pages.AddPage(idx, textView, true, false)
 	}
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha256"
-	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"hash/fnv"
@@ -21,7 +20,6 @@ import (
 	"github.com/cometbft/cometbft/p2p"
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
-	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	libclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -35,12 +33,12 @@ import (
 	dockerclient "github.com/docker/docker/client"
 	"github.com/docker/go-connections/nat"
 	"go.uber.org/zap"
-	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	"github.com/strangelove-ventures/interchaintest/v8/blockdb"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/internal/tendermint"
 	"github.com/strangelove-ventures/interchaintest/v8/dockerutil"
 	"github.com/strangelove-ventures/interchaintest/v8/ibc"
 	"github.com/strangelove-ventures/interchaintest/v8/testutil"
@@ -396,82 +394,46 @@ func (tn *ChainNode) Height(ctx context.Context) (int64, error) {
 	return height, nil
 }
 
-// FindTxs implements blockdb.BlockSaver.
+// FindTxs implements blockdb.TxFinder.
+// The block-level events are returned in a transaction artificially created for debugging purposes.
 func (tn *ChainNode) FindTxs(ctx context.Context, height int64) ([]blockdb.Tx, error) {
-	h := int64(height)
-	var eg errgroup.Group
-	var blockRes *coretypes.ResultBlockResults
-	var block *coretypes.ResultBlock
-	eg.Go(func() (err error) {
-		blockRes, err = tn.Client.BlockResults(ctx, &h)
-		return err
-	})
-	eg.Go(func() (err error) {
-		block, err = tn.Client.Block(ctx, &h)
-		return err
-	})
-	if err := eg.Wait(); err != nil {
+	block, err := tn.FindBlock(ctx, height)
+	if err != nil {
 		return nil, err
 	}
-	interfaceRegistry := tn.Chain.Config().EncodingConfig.InterfaceRegistry
-	txs := make([]blockdb.Tx, 0, len(block.Block.Txs)+2)
-	for i, tx := range block.Block.Txs {
-		var newTx blockdb.Tx
-		newTx.Data = []byte(fmt.Sprintf(`{"data":"%s"}`, hex.EncodeToString(tx)))
+	return blockTxs(block), nil
+}
 
-		sdkTx, err := decodeTX(interfaceRegistry, tx)
-		if err != nil {
-			tn.logger().Info("Failed to decode tx", zap.Int64("height", height), zap.Error(err))
-			continue
-		}
-		b, err := encodeTxToJSON(interfaceRegistry, sdkTx)
-		if err != nil {
-			tn.logger().Info("Failed to marshal tx to json", zap.Int64("height", height), zap.Error(err))
-			continue
-		}
-		newTx.Data = b
+// blockTxs returns the txs of block, with the block-level events in an artificial tx,
+// for a blockdb.BlockSaver which only saves txs.
+func blockTxs(block blockdb.Block) []blockdb.Tx {
+	txs := block.Txs
+	if len(block.Events) > 0 {
+		txs = append(txs, blockdb.Tx{
+			Data:   []byte(`{"data":"finalize_block","note":"this is a transaction artificially created for debugging purposes"}`),
+			Events: block.Events,
+		})
+	}
+	return txs
+}
 
-		rTx := blockRes.TxsResults[i]
+// FindBlock implements blockdb.BlockFinder.
+func (tn *ChainNode) FindBlock(ctx context.Context, height int64) (blockdb.Block, error) {
+	interfaceRegistry := tn.Chain.Config().EncodingConfig.InterfaceRegistry
+	return tendermint.FindBlock(ctx, tn.logger(), tn.Client, height, func(tx []byte) ([]byte, error) {
+		return txToJSON(interfaceRegistry, tx)
+	})
+}
 
-		newTx.Events = make([]blockdb.Event, len(rTx.Events))
-		for j, e := range rTx.Events {
-			attrs := make([]blockdb.EventAttribute, len(e.Attributes))
-			for k, attr := range e.Attributes {
-				attrs[k] = blockdb.EventAttribute{
-					Key:   string(attr.Key),
-					Value: string(attr.Value),
-				}
-			}
-			newTx.Events[j] = blockdb.Event{
-				Type:       e.Type,
-				Attributes: attrs,
-			}
-		}
-		txs = append(txs, newTx)
-	}
-	if len(blockRes.FinalizeBlockEvents) > 0 {
-		finalizeBlockTx := blockdb.Tx{
-			Data: []byte(`{"data":"finalize_block","note":"this is a transaction artificially created for debugging purposes"}`),
-		}
-		finalizeBlockTx.Events = make([]blockdb.Event, len(blockRes.FinalizeBlockEvents))
-		for i, e := range blockRes.FinalizeBlockEvents {
-			attrs := make([]blockdb.EventAttribute, len(e.Attributes))
-			for j, attr := range e.Attributes {
-				attrs[j] = blockdb.EventAttribute{
-					Key:   string(attr.Key),
-					Value: string(attr.Value),
-				}
-			}
-			finalizeBlockTx.Events[i] = blockdb.Event{
-				Type:       e.Type,
-				Attributes: attrs,
-			}
-		}
-		txs = append(txs, finalizeBlockTx)
-	}
-	return txs, nil
+// NotifyNewBlocks implements blockdb.BlockNotifier.
+func (tn *ChainNode) NotifyNewBlocks(ctx context.Context) (<-chan int64, error) {
+	return tendermint.NotifyNewBlocks(ctx, tn.logger(), "tcp://"+tn.hostRPCPort, newBlocksStaleAfter)
 }
 
+// newBlocksStaleAfter is how long a subscription to new blocks waits for a block before ending,
+// so that blockdb resubscribes after the node restarted.
+const newBlocksStaleAfter = 30 * time.Second
+
 // TxCommand is a helper to retrieve a full command for broadcasting a tx
 // with the chain node binary.
 func (tn *ChainNode) TxCommand(keyName string, command ...string) []string {
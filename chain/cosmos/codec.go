@@ -1,11 +1,17 @@
 package cosmos
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
 	"cosmossdk.io/x/upgrade"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module/testutil"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authTx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	"github.com/cosmos/cosmos-sdk/x/bank"
@@ -62,3 +68,85 @@ func encodeTxToJSON(interfaceRegistry codectypes.InterfaceRegistry, tx sdk.Tx) (
 	cdc := codec.NewProtoCodec(interfaceRegistry)
 	return authTx.DefaultJSONTxEncoder(cdc)(tx)
 }
+
+// txToJSON decodes a raw tx into JSON with the interface registry of the chain, for blockdb.
+// If some messages do not decode, e.g. because the registry lacks their type, the other messages are still decoded,
+// and the JSON is returned along with the error.
+func txToJSON(interfaceRegistry codectypes.InterfaceRegistry, txbz []byte) ([]byte, error) {
+	sdkTx, err := decodeTX(interfaceRegistry, txbz)
+	if err != nil {
+		b, msgErr := partialTxToJSON(interfaceRegistry, txbz)
+		if b == nil {
+			return nil, err
+		}
+		if msgErr != nil {
+			return b, msgErr
+		}
+		return b, err
+	}
+	b, err := encodeTxToJSON(interfaceRegistry, sdkTx)
+	if err != nil {
+		return nil, fmt.Errorf("marshal tx to json: %w", err)
+	}
+	return b, nil
+}
+
+// partialTxToJSON decodes the messages of a tx one by one, keeping those which fail to decode as their type URL
+// and base64 encoded value. It returns nil if the tx itself does not decode, otherwise the JSON and the errors
+// of the messages which failed to decode, if any.
+func partialTxToJSON(interfaceRegistry codectypes.InterfaceRegistry, txbz []byte) ([]byte, error) {
+	var raw txtypes.TxRaw
+	if err := raw.Unmarshal(txbz); err != nil {
+		return nil, nil
+	}
+	var body txtypes.TxBody
+	if err := body.Unmarshal(raw.BodyBytes); err != nil {
+		return nil, nil
+	}
+
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	var errs []error
+	msgs := make([]json.RawMessage, len(body.Messages))
+	for i, anyMsg := range body.Messages {
+		b, err := anyToJSON(cdc, interfaceRegistry, anyMsg)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("message %d: %w", i, err))
+			b, _ = json.Marshal(struct {
+				Type  string `json:"@type"`
+				Value []byte `json:"value"`
+			}{anyMsg.TypeUrl, anyMsg.Value})
+		}
+		msgs[i] = b
+	}
+
+	tx := map[string]any{
+		"body": map[string]any{
+			"messages":       msgs,
+			"memo":           body.Memo,
+			"timeout_height": strconv.FormatUint(body.TimeoutHeight, 10),
+		},
+		"signatures": raw.Signatures,
+	}
+	var authInfo txtypes.AuthInfo
+	if err := authInfo.Unmarshal(raw.AuthInfoBytes); err == nil {
+		if b, err := cdc.MarshalJSON(&authInfo); err == nil {
+			tx["auth_info"] = json.RawMessage(b)
+		}
+	}
+	b, err := json.Marshal(tx)
+	if err != nil {
+		return nil, nil
+	}
+	return b, errors.Join(errs...)
+}
+
+func anyToJSON(cdc codec.Codec, interfaceRegistry codectypes.InterfaceRegistry, anyMsg *codectypes.Any) ([]byte, error) {
+	msg, err := interfaceRegistry.Resolve(anyMsg.TypeUrl)
+	if err != nil {
+		return nil, err
+	}
+	if err := cdc.Unmarshal(anyMsg.Value, msg); err != nil {
+		return nil, fmt.Errorf("unmarshal %s: %w", anyMsg.TypeUrl, err)
+	}
+	return cdc.MarshalInterfaceJSON(msg)
+}
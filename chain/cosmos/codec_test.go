@@ -0,0 +1,72 @@
+package cosmos
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxToJSON(t *testing.T) {
+	t.Parallel()
+
+	registry := DefaultEncoding().InterfaceRegistry
+	send, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{
+		FromAddress: "cosmos1from",
+		ToAddress:   "cosmos1to",
+		Amount:      sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(5))),
+	})
+	require.NoError(t, err)
+
+	rawTx := func(msgs ...*codectypes.Any) []byte {
+		body, err := (&txtypes.TxBody{Messages: msgs, Memo: "memo"}).Marshal()
+		require.NoError(t, err)
+		authInfo, err := (&txtypes.AuthInfo{Fee: &txtypes.Fee{GasLimit: 100}}).Marshal()
+		require.NoError(t, err)
+		b, err := (&txtypes.TxRaw{BodyBytes: body, AuthInfoBytes: authInfo, Signatures: [][]byte{{1}}}).Marshal()
+		require.NoError(t, err)
+		return b
+	}
+	type decodedTx struct {
+		Body struct {
+			Messages []map[string]any `json:"messages"`
+			Memo     string           `json:"memo"`
+		} `json:"body"`
+	}
+
+	t.Run("registered messages", func(t *testing.T) {
+		b, err := txToJSON(registry, rawTx(send))
+		require.NoError(t, err)
+		var tx decodedTx
+		require.NoError(t, json.Unmarshal(b, &tx))
+		require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", tx.Body.Messages[0]["@type"])
+		require.Equal(t, "memo", tx.Body.Memo)
+	})
+
+	t.Run("unregistered message", func(t *testing.T) {
+		custom := &codectypes.Any{TypeUrl: "/mychain.mymodule.v1.MsgCustom", Value: []byte{0x0a, 0x01, 0x61}}
+		b, err := txToJSON(registry, rawTx(send, custom))
+		require.ErrorContains(t, err, "message 1")
+		require.ErrorContains(t, err, "/mychain.mymodule.v1.MsgCustom")
+
+		var tx decodedTx
+		require.NoError(t, json.Unmarshal(b, &tx))
+		require.Len(t, tx.Body.Messages, 2)
+		require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", tx.Body.Messages[0]["@type"])
+		require.Equal(t, "cosmos1to", tx.Body.Messages[0]["to_address"])
+		require.Equal(t, "/mychain.mymodule.v1.MsgCustom", tx.Body.Messages[1]["@type"])
+		require.Equal(t, "CgFh", tx.Body.Messages[1]["value"])
+		require.Equal(t, "memo", tx.Body.Memo)
+	})
+
+	t.Run("not a tx", func(t *testing.T) {
+		b, err := txToJSON(registry, []byte("not a tx"))
+		require.Error(t, err)
+		require.Nil(t, b)
+	})
+}
@@ -344,6 +344,23 @@ func (c *CosmosChain) SendIBCTransfer(
 	if err != nil {
 		return tx, fmt.Errorf("send ibc transfer: %w", err)
 	}
+	return c.sentPacketTx(txHash)
+}
+
+// SendIBCPacket executes an arbitrary tx command which sends a single IBC packet,
+// such as an application specific transfer or a wasm contract execution,
+// and returns the tx along with the packet it sent.
+// Use ibc.DecodePacketData to inspect the application data of the packet.
+func (c *CosmosChain) SendIBCPacket(ctx context.Context, keyName string, command ...string) (ibc.Tx, error) {
+	txHash, err := c.getFullNode().ExecTx(ctx, keyName, command...)
+	if err != nil {
+		return ibc.Tx{}, fmt.Errorf("send ibc packet: %w", err)
+	}
+	return c.sentPacketTx(txHash)
+}
+
+// sentPacketTx builds an ibc.Tx from the send_packet event of the tx with txHash.
+func (c *CosmosChain) sentPacketTx(txHash string) (tx ibc.Tx, _ error) {
 	txResp, err := c.GetTransaction(txHash)
 	if err != nil {
 		return tx, fmt.Errorf("failed to get transaction %s: %w", txHash, err)
@@ -445,6 +462,37 @@ func (c *CosmosChain) PushNewWasmClientProposal(ctx context.Context, keyName str
 	return tx, codeHash, err
 }
 
+// MigrateWasmClientProposal submits a governance proposal to migrate the 08-wasm light client clientID
+// to the contract code with the given hex encoded checksum, which must already be stored on the chain,
+// e.g. through PushNewWasmClientProposal. migrateMsg is passed to the migrate entry point of the new contract
+// and defaults to an empty JSON object.
+func (c *CosmosChain) MigrateWasmClientProposal(ctx context.Context, keyName, clientID, checksum string, migrateMsg []byte, prop TxProposalv1) (TxProposal, error) {
+	tx := TxProposal{}
+	checksumBz, err := hex.DecodeString(checksum)
+	if err != nil {
+		return tx, fmt.Errorf("invalid wasm client checksum %q: %w", checksum, err)
+	}
+	if len(migrateMsg) == 0 {
+		migrateMsg = []byte("{}")
+	}
+	message := wasmtypes.MsgMigrateContract{
+		Signer:   types.MustBech32ifyAddressBytes(c.cfg.Bech32Prefix, authtypes.NewModuleAddress(govtypes.ModuleName)),
+		ClientId: clientID,
+		Checksum: checksumBz,
+		Msg:      migrateMsg,
+	}
+	msg, err := c.cfg.EncodingConfig.Codec.MarshalInterfaceJSON(&message)
+	if err != nil {
+		return tx, err
+	}
+	prop.Messages = append(prop.Messages, msg)
+	txHash, err := c.getFullNode().SubmitProposal(ctx, keyName, prop)
+	if err != nil {
+		return tx, fmt.Errorf("failed to submit wasm client migration proposal: %w", err)
+	}
+	return c.txProposal(txHash)
+}
+
 // UpgradeProposal submits a software-upgrade governance proposal to the chain.
 func (c *CosmosChain) UpgradeProposal(ctx context.Context, keyName string, prop SoftwareUpgradeProposal) (tx TxProposal, _ error) {
 	txHash, err := c.getFullNode().UpgradeProposal(ctx, keyName, prop)
@@ -1092,12 +1140,34 @@ func (c *CosmosChain) Timeouts(ctx context.Context, height int64) ([]ibc.PacketT
 	return ibcTimeouts, nil
 }
 
-// FindTxs implements blockdb.BlockSaver.
+// FindTxs implements blockdb.TxFinder.
 func (c *CosmosChain) FindTxs(ctx context.Context, height int64) ([]blockdb.Tx, error) {
-	fn := c.getFullNode()
 	c.findTxMu.Lock()
 	defer c.findTxMu.Unlock()
-	return fn.FindTxs(ctx, height)
+	return c.blockNode().FindTxs(ctx, height)
+}
+
+// FindBlock implements blockdb.BlockFinder.
+func (c *CosmosChain) FindBlock(ctx context.Context, height int64) (blockdb.Block, error) {
+	c.findTxMu.Lock()
+	defer c.findTxMu.Unlock()
+	return c.blockNode().FindBlock(ctx, height)
+}
+
+// NotifyNewBlocks implements blockdb.BlockNotifier.
+func (c *CosmosChain) NotifyNewBlocks(ctx context.Context) (<-chan int64, error) {
+	c.findTxMu.Lock()
+	defer c.findTxMu.Unlock()
+	return c.blockNode().NotifyNewBlocks(ctx)
+}
+
+// blockNode is the node which blocks are collected from for the block database.
+// A full node is preferred, so that collecting blocks does not load the validators.
+func (c *CosmosChain) blockNode() *ChainNode {
+	if len(c.FullNodes) > 0 {
+		return c.FullNodes[0]
+	}
+	return c.GetNode()
 }
 
 // StopAllNodes stops and removes all long running containers (validators and full nodes)
@@ -0,0 +1,177 @@
+package cosmos
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+)
+
+// Helpers for ICS-721 NFT transfers through the cw-ics721 contract, which bridges cw721 collections
+// over a channel bound to the "wasm.<ics721 address>" port.
+
+// ICS721OutgoingMsg is the message attached to a cw721 send_nft call which tells
+// the ics721 contract where to transfer the NFT.
+type ICS721OutgoingMsg struct {
+	Receiver  string        `json:"receiver"`
+	ChannelID string        `json:"channel_id"`
+	Timeout   ICS721Timeout `json:"timeout"`
+	Memo      string        `json:"memo,omitempty"`
+}
+
+// ICS721Timeout mirrors the cosmwasm IbcTimeout type. At least one field must be set.
+type ICS721Timeout struct {
+	Block     *ICS721TimeoutBlock `json:"block,omitempty"`
+	Timestamp string              `json:"timestamp,omitempty"` // Nanoseconds since the unix epoch.
+}
+
+type ICS721TimeoutBlock struct {
+	Revision uint64 `json:"revision"`
+	Height   uint64 `json:"height"`
+}
+
+// defaultICS721Timeout matches the relative timeout ibc-go applies to ICS-20 transfers.
+const defaultICS721Timeout = 10 * time.Minute
+
+// ICS721SendNFT transfers tokenID of the cw721 collection to receiver on the counterparty of channelID,
+// escrowing it in the ics721 contract. As with ICS-20 transfers, a timeout in options is relative: NanoSeconds
+// are added to the current time and Height to the latest counterparty height known to the channel's client.
+// If options do not set a timeout, the packet times out after ten minutes.
+func (c *CosmosChain) ICS721SendNFT(ctx context.Context, keyName, cw721Contract, ics721Contract, tokenID, channelID, receiver string, options ibc.TransferOptions) (ibc.Tx, error) {
+	timeout, err := ics721Timeout(options.Timeout, time.Now(), func() (*ibctm.ClientState, error) {
+		return c.ics721ChannelClientState(ctx, "wasm."+ics721Contract, channelID)
+	})
+	if err != nil {
+		return ibc.Tx{}, err
+	}
+	outgoing := ICS721OutgoingMsg{
+		Receiver:  receiver,
+		ChannelID: channelID,
+		Timeout:   timeout,
+		Memo:      options.Memo,
+	}
+
+	outgoingJSON, err := json.Marshal(outgoing)
+	if err != nil {
+		return ibc.Tx{}, err
+	}
+
+	sendNFT, err := json.Marshal(map[string]any{
+		"send_nft": map[string]string{
+			"contract": ics721Contract,
+			"token_id": tokenID,
+			"msg":      base64.StdEncoding.EncodeToString(outgoingJSON),
+		},
+	})
+	if err != nil {
+		return ibc.Tx{}, err
+	}
+
+	return c.SendIBCPacket(ctx, keyName, "wasm", "execute", cw721Contract, string(sendNFT), "--gas", "auto")
+}
+
+// ics721Timeout converts the relative timeout to the absolute one expected by the ics721 contract.
+// counterparty returns the client state of the channel and is only called for height timeouts.
+func ics721Timeout(timeout *ibc.IBCTimeout, now time.Time, counterparty func() (*ibctm.ClientState, error)) (ICS721Timeout, error) {
+	switch {
+	case timeout != nil && timeout.NanoSeconds > 0:
+		return ICS721Timeout{Timestamp: strconv.FormatUint(uint64(now.UnixNano())+timeout.NanoSeconds, 10)}, nil
+	case timeout != nil && timeout.Height > 0:
+		clientState, err := counterparty()
+		if err != nil {
+			return ICS721Timeout{}, fmt.Errorf("query counterparty height: %w", err)
+		}
+		return ICS721Timeout{Block: &ICS721TimeoutBlock{
+			Revision: clienttypes.ParseChainID(clientState.ChainId),
+			Height:   clientState.LatestHeight.RevisionHeight + uint64(timeout.Height),
+		}}, nil
+	default:
+		return ICS721Timeout{Timestamp: strconv.FormatInt(now.Add(defaultICS721Timeout).UnixNano(), 10)}, nil
+	}
+}
+
+// ics721ChannelClientState returns the tendermint client state of the client underlying the channel.
+func (c *CosmosChain) ics721ChannelClientState(ctx context.Context, portID, channelID string) (*ibctm.ClientState, error) {
+	res, err := chantypes.NewQueryClient(c.GetNode().GrpcConn).ChannelClientState(ctx, &chantypes.QueryChannelClientStateRequest{
+		PortId:    portID,
+		ChannelId: channelID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var clientState exported.ClientState
+	if err := c.Config().EncodingConfig.InterfaceRegistry.UnpackAny(res.IdentifiedClientState.ClientState, &clientState); err != nil {
+		return nil, err
+	}
+	tmClientState, ok := clientState.(*ibctm.ClientState)
+	if !ok {
+		return nil, fmt.Errorf("client of channel %s is a %s client, not tendermint", channelID, clientState.ClientType())
+	}
+	return tmClientState, nil
+}
+
+// ICS721QueryClassID returns the class ID the ics721 contract has assigned to the cw721 collection,
+// or an empty string if the collection has never been transferred.
+func (c *CosmosChain) ICS721QueryClassID(ctx context.Context, ics721Contract, cw721Contract string) (string, error) {
+	var res struct {
+		Data *string `json:"data"`
+	}
+	query := map[string]any{"class_id": map[string]string{"contract": cw721Contract}}
+	if err := c.QueryContract(ctx, ics721Contract, query, &res); err != nil {
+		return "", fmt.Errorf("query class id of %s: %w", cw721Contract, err)
+	}
+	if res.Data == nil {
+		return "", nil
+	}
+	return *res.Data, nil
+}
+
+// ICS721QueryNFTContract returns the cw721 collection the ics721 contract uses for classID,
+// such as the voucher collection instantiated for a class received over a channel.
+// It returns an empty string if no collection exists for classID.
+func (c *CosmosChain) ICS721QueryNFTContract(ctx context.Context, ics721Contract, classID string) (string, error) {
+	var res struct {
+		Data *string `json:"data"`
+	}
+	query := map[string]any{"nft_contract": map[string]string{"class_id": classID}}
+	if err := c.QueryContract(ctx, ics721Contract, query, &res); err != nil {
+		return "", fmt.Errorf("query nft contract of %s: %w", classID, err)
+	}
+	if res.Data == nil {
+		return "", nil
+	}
+	return *res.Data, nil
+}
+
+// CW721QueryOwnerOf returns the owner of tokenID in the cw721 collection.
+func (c *CosmosChain) CW721QueryOwnerOf(ctx context.Context, cw721Contract, tokenID string) (string, error) {
+	var res struct {
+		Data struct {
+			Owner string `json:"owner"`
+		} `json:"data"`
+	}
+	query := map[string]any{"owner_of": map[string]string{"token_id": tokenID}}
+	if err := c.QueryContract(ctx, cw721Contract, query, &res); err != nil {
+		return "", fmt.Errorf("query owner of token %s: %w", tokenID, err)
+	}
+	return res.Data.Owner, nil
+}
+
+// ICS721IsEscrowed reports whether tokenID of the cw721 collection is held in escrow by the ics721 contract,
+// which is the case after it was sent to a chain that is not its origin.
+func (c *CosmosChain) ICS721IsEscrowed(ctx context.Context, ics721Contract, cw721Contract, tokenID string) (bool, error) {
+	owner, err := c.CW721QueryOwnerOf(ctx, cw721Contract, tokenID)
+	if err != nil {
+		return false, err
+	}
+	return owner == ics721Contract, nil
+}
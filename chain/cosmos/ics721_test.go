@@ -0,0 +1,48 @@
+package cosmos
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/stretchr/testify/require"
+
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+)
+
+func TestICS721Timeout(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	noQuery := func() (*ibctm.ClientState, error) {
+		t.Fatal("unexpected counterparty query")
+		return nil, nil
+	}
+
+	t.Run("timestamp", func(t *testing.T) {
+		timeout, err := ics721Timeout(&ibc.IBCTimeout{NanoSeconds: uint64(time.Minute)}, now, noQuery)
+		require.NoError(t, err)
+		require.Nil(t, timeout.Block)
+		require.Equal(t, "1700000060000000000", timeout.Timestamp)
+	})
+
+	t.Run("height", func(t *testing.T) {
+		timeout, err := ics721Timeout(&ibc.IBCTimeout{Height: 20}, now, func() (*ibctm.ClientState, error) {
+			return &ibctm.ClientState{ChainId: "juno-3", LatestHeight: clienttypes.NewHeight(3, 100)}, nil
+		})
+		require.NoError(t, err)
+		require.Empty(t, timeout.Timestamp)
+		require.Equal(t, &ICS721TimeoutBlock{Revision: 3, Height: 120}, timeout.Block)
+
+		_, err = ics721Timeout(&ibc.IBCTimeout{Height: 20}, now, func() (*ibctm.ClientState, error) {
+			return nil, errors.New("boom")
+		})
+		require.ErrorContains(t, err, "boom")
+	})
+
+	t.Run("default", func(t *testing.T) {
+		timeout, err := ics721Timeout(nil, now, noQuery)
+		require.NoError(t, err)
+		require.Equal(t, "1700000600000000000", timeout.Timestamp)
+	})
+}
@@ -0,0 +1,156 @@
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/privval"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+)
+
+// ConflictingHeaders returns two tendermint headers for the given height of c which conflict with each other.
+// The first header is the one committed by the chain. The second is a fork of it with a different app hash,
+// signed by the same validators using their keys from the validator nodes.
+//
+// trustedHeight must be a consensus state height stored by the client tracking c on the counterparty,
+// e.g. the latest height of its client state, and height must be greater than trustedHeight.
+// Submitting both headers through IBCSubmitMisbehaviour freezes that client.
+func (c *CosmosChain) ConflictingHeaders(ctx context.Context, height int64, trustedHeight clienttypes.Height) (*ibctm.Header, *ibctm.Header, error) {
+	commit, err := c.GetNode().Client.Commit(ctx, &height)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get commit at height %d: %w", height, err)
+	}
+
+	valSet, err := c.validatorSet(ctx, height)
+	if err != nil {
+		return nil, nil, err
+	}
+	trustedValSet, err := c.validatorSet(ctx, int64(trustedHeight.RevisionHeight)+1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	valSetProto, err := valSet.ToProto()
+	if err != nil {
+		return nil, nil, err
+	}
+	trustedValSetProto, err := trustedValSet.ToProto()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	forkedHeader := *commit.Header
+	forkedHeader.AppHash = tmhash.Sum([]byte(fmt.Sprintf("forked-%s-%d", c.cfg.ChainID, height)))
+
+	forkedCommit, err := c.signForkedCommit(ctx, &forkedHeader, commit.Commit, valSet)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	header1 := &ibctm.Header{
+		SignedHeader:      commit.SignedHeader.ToProto(),
+		ValidatorSet:      valSetProto,
+		TrustedHeight:     trustedHeight,
+		TrustedValidators: trustedValSetProto,
+	}
+	header2 := &ibctm.Header{
+		SignedHeader: &cmtproto.SignedHeader{
+			Header: forkedHeader.ToProto(),
+			Commit: forkedCommit.ToProto(),
+		},
+		ValidatorSet:      valSetProto,
+		TrustedHeight:     trustedHeight,
+		TrustedValidators: trustedValSetProto,
+	}
+	return header1, header2, nil
+}
+
+// signForkedCommit produces a commit for header signed by each validator in valSet whose key is held by c.
+func (c *CosmosChain) signForkedCommit(ctx context.Context, header *cmttypes.Header, original *cmttypes.Commit, valSet *cmttypes.ValidatorSet) (*cmttypes.Commit, error) {
+	privVals, err := c.privValidators(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blockID := cmttypes.BlockID{
+		Hash:          header.Hash(),
+		PartSetHeader: original.BlockID.PartSetHeader,
+	}
+
+	sigs := make([]cmttypes.CommitSig, len(valSet.Validators))
+	for i, val := range valSet.Validators {
+		pv, ok := privVals[val.Address.String()]
+		if !ok {
+			sigs[i] = cmttypes.NewCommitSigAbsent()
+			continue
+		}
+
+		vote := &cmttypes.Vote{
+			Type:             cmtproto.PrecommitType,
+			Height:           header.Height,
+			Round:            original.Round,
+			BlockID:          blockID,
+			Timestamp:        header.Time,
+			ValidatorAddress: val.Address,
+			ValidatorIndex:   int32(i),
+		}
+		v := vote.ToProto()
+		if err := pv.SignVote(header.ChainID, v); err != nil {
+			return nil, fmt.Errorf("failed to sign vote for validator %s: %w", val.Address, err)
+		}
+		vote.Signature = v.Signature
+		sigs[i] = vote.CommitSig()
+	}
+
+	return &cmttypes.Commit{
+		Height:     header.Height,
+		Round:      original.Round,
+		BlockID:    blockID,
+		Signatures: sigs,
+	}, nil
+}
+
+// validatorSet returns the full validator set of c at height.
+func (c *CosmosChain) validatorSet(ctx context.Context, height int64) (*cmttypes.ValidatorSet, error) {
+	var (
+		vals    []*cmttypes.Validator
+		page    = 1
+		perPage = 100
+	)
+	for {
+		res, err := c.GetNode().Client.Validators(ctx, &height, &page, &perPage)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get validators at height %d: %w", height, err)
+		}
+		vals = append(vals, res.Validators...)
+		if len(vals) >= res.Total {
+			break
+		}
+		page++
+	}
+	return cmttypes.NewValidatorSet(vals), nil
+}
+
+// privValidators loads the consensus keys of c's validator nodes, keyed by validator address.
+func (c *CosmosChain) privValidators(ctx context.Context) (map[string]cmttypes.PrivValidator, error) {
+	privVals := make(map[string]cmttypes.PrivValidator, len(c.Validators))
+	for _, v := range c.Validators {
+		bz, err := v.ReadFile(ctx, path.Join("config", "priv_validator_key.json"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read priv_validator_key.json from %s: %w", v.Name(), err)
+		}
+
+		var key privval.FilePVKey
+		if err := cmtjson.Unmarshal(bz, &key); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal priv_validator_key.json from %s: %w", v.Name(), err)
+		}
+		privVals[key.Address.String()] = cmttypes.NewMockPVWithParams(key.PrivKey, false, false)
+	}
+	return privVals, nil
+}
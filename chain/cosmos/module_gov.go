@@ -24,6 +24,25 @@ func (tn *ChainNode) VoteOnProposal(ctx context.Context, keyName string, proposa
 	return err
 }
 
+// WeightedVoteOnProposal submits a weighted vote for the specified proposal,
+// with options formatted as e.g. "yes=0.6,no=0.4".
+func (tn *ChainNode) WeightedVoteOnProposal(ctx context.Context, keyName string, proposalID uint64, options string) error {
+	_, err := tn.ExecTx(ctx, keyName,
+		"gov", "weighted-vote",
+		fmt.Sprintf("%d", proposalID), options, "--gas", "auto",
+	)
+	return err
+}
+
+// DepositOnProposal deposits amount, e.g. "1000stake", on the specified proposal.
+func (tn *ChainNode) DepositOnProposal(ctx context.Context, keyName string, proposalID uint64, amount string) error {
+	_, err := tn.ExecTx(ctx, keyName,
+		"gov", "deposit",
+		fmt.Sprintf("%d", proposalID), amount, "--gas", "auto",
+	)
+	return err
+}
+
 // SubmitProposal submits a gov v1 proposal to the chain.
 func (tn *ChainNode) SubmitProposal(ctx context.Context, keyName string, prop TxProposalv1) (string, error) {
 	file := "proposal.json"
@@ -0,0 +1,156 @@
+package cosmos
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+)
+
+// IBCChannelUpgradeProposal submits a governance proposal which initiates an upgrade of the channel to the
+// given fields, e.g. to wrap the application in fee middleware or to change the channel ordering.
+// Once the proposal passes, a relayer completes the handshake through ibc.Relayer.UpgradeChannel.
+func (c *CosmosChain) IBCChannelUpgradeProposal(ctx context.Context, keyName, portID, channelID string, fields chantypes.UpgradeFields, title, deposit string) (TxProposal, error) {
+	msg := chantypes.NewMsgChannelUpgradeInit(portID, channelID, fields, c.govAuthority())
+	return c.submitIBCProposal(ctx, keyName, msg, title, deposit)
+}
+
+// IBCChannelUpgradeCancelProposal submits a governance proposal which cancels an in-progress upgrade of the channel.
+// Until the channel reaches FLUSHCOMPLETE, a cancel signed by the authority does not require an error receipt.
+func (c *CosmosChain) IBCChannelUpgradeCancelProposal(ctx context.Context, keyName, portID, channelID, title, deposit string) (TxProposal, error) {
+	msg := chantypes.NewMsgChannelUpgradeCancel(portID, channelID, chantypes.ErrorReceipt{}, nil, clienttypes.ZeroHeight(), c.govAuthority())
+	return c.submitIBCProposal(ctx, keyName, msg, title, deposit)
+}
+
+// IBCChannelUpgradeInit initiates an upgrade of the channel with a tx signed by authority.
+// This is only accepted by chains whose IBC module authority is set to the signing account rather than governance.
+func (c *CosmosChain) IBCChannelUpgradeInit(ctx context.Context, b *Broadcaster, authority User, portID, channelID string, fields chantypes.UpgradeFields) (sdk.TxResponse, error) {
+	msg := chantypes.NewMsgChannelUpgradeInit(portID, channelID, fields, authority.FormattedAddress())
+	return BroadcastTx(ctx, b, authority, msg)
+}
+
+// IBCChannelUpgradeCancel cancels an in-progress upgrade of the channel with a tx signed by authority.
+func (c *CosmosChain) IBCChannelUpgradeCancel(ctx context.Context, b *Broadcaster, authority User, portID, channelID string) (sdk.TxResponse, error) {
+	msg := chantypes.NewMsgChannelUpgradeCancel(portID, channelID, chantypes.ErrorReceipt{}, nil, clienttypes.ZeroHeight(), authority.FormattedAddress())
+	return BroadcastTx(ctx, b, authority, msg)
+}
+
+// IBCRecoverClientProposal submits a governance proposal which replaces the state of an expired or frozen
+// subject client with that of an active substitute client of the same type.
+func (c *CosmosChain) IBCRecoverClientProposal(ctx context.Context, keyName, subjectClientID, substituteClientID, title, deposit string) (TxProposal, error) {
+	msg := clienttypes.NewMsgRecoverClient(c.govAuthority(), subjectClientID, substituteClientID)
+	return c.submitIBCProposal(ctx, keyName, msg, title, deposit)
+}
+
+// IBCSubmitMisbehaviour submits the conflicting headers as misbehaviour for clientID, which is hosted by c,
+// in a tx signed by user. See ConflictingHeaders for building the headers on the counterparty.
+func (c *CosmosChain) IBCSubmitMisbehaviour(ctx context.Context, b *Broadcaster, user User, clientID string, header1, header2 *ibctm.Header) (sdk.TxResponse, error) {
+	msg, err := clienttypes.NewMsgUpdateClient(clientID, ibctm.NewMisbehaviour(clientID, header1, header2), user.FormattedAddress())
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+	return BroadcastTx(ctx, b, user, msg)
+}
+
+// IBCQueryClientState returns the client state of clientID.
+func (c *CosmosChain) IBCQueryClientState(ctx context.Context, clientID string) (exported.ClientState, error) {
+	res, err := clienttypes.NewQueryClient(c.GetNode().GrpcConn).ClientState(ctx, &clienttypes.QueryClientStateRequest{
+		ClientId: clientID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var clientState exported.ClientState
+	if err := c.Config().EncodingConfig.InterfaceRegistry.UnpackAny(res.ClientState, &clientState); err != nil {
+		return nil, err
+	}
+	return clientState, nil
+}
+
+// IBCQueryClientStatus returns the status of clientID, e.g. "Active", "Expired" or "Frozen".
+func (c *CosmosChain) IBCQueryClientStatus(ctx context.Context, clientID string) (string, error) {
+	res, err := clienttypes.NewQueryClient(c.GetNode().GrpcConn).ClientStatus(ctx, &clienttypes.QueryClientStatusRequest{
+		ClientId: clientID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return res.Status, nil
+}
+
+// IBCQueryChannel returns the channel end for the given port and channel.
+func (c *CosmosChain) IBCQueryChannel(ctx context.Context, portID, channelID string) (*chantypes.Channel, error) {
+	res, err := chantypes.NewQueryClient(c.GetNode().GrpcConn).Channel(ctx, &chantypes.QueryChannelRequest{
+		PortId:    portID,
+		ChannelId: channelID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Channel, nil
+}
+
+// IBCQueryChannelUpgrade returns the upgrade currently in progress for the channel.
+func (c *CosmosChain) IBCQueryChannelUpgrade(ctx context.Context, portID, channelID string) (*chantypes.Upgrade, error) {
+	res, err := chantypes.NewQueryClient(c.GetNode().GrpcConn).Upgrade(ctx, &chantypes.QueryUpgradeRequest{
+		PortId:    portID,
+		ChannelId: channelID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Upgrade, nil
+}
+
+// IBCQueryChannelUpgradeError returns the error receipt written for the latest failed upgrade of the channel.
+func (c *CosmosChain) IBCQueryChannelUpgradeError(ctx context.Context, portID, channelID string) (*chantypes.ErrorReceipt, error) {
+	res, err := chantypes.NewQueryClient(c.GetNode().GrpcConn).UpgradeError(ctx, &chantypes.QueryUpgradeErrorRequest{
+		PortId:    portID,
+		ChannelId: channelID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.ErrorReceipt, nil
+}
+
+// IBCQueryChannelParams returns the channel module parameters, which include the default upgrade timeout.
+func (c *CosmosChain) IBCQueryChannelParams(ctx context.Context) (*chantypes.Params, error) {
+	res, err := chantypes.NewQueryClient(c.GetNode().GrpcConn).ChannelParams(ctx, &chantypes.QueryChannelParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Params, nil
+}
+
+// govAuthority returns the address of the gov module, the default authority of the IBC module.
+func (c *CosmosChain) govAuthority() string {
+	return sdk.MustBech32ifyAddressBytes(c.cfg.Bech32Prefix, authtypes.NewModuleAddress(govtypes.ModuleName))
+}
+
+// submitIBCProposal wraps msg in a gov v1 proposal submitted by keyName.
+func (c *CosmosChain) submitIBCProposal(ctx context.Context, keyName string, msg ProtoMessage, title, deposit string) (TxProposal, error) {
+	proposer, err := c.getFullNode().AccountKeyBech32(ctx, keyName)
+	if err != nil {
+		return TxProposal{}, fmt.Errorf("failed to get proposer address: %w", err)
+	}
+
+	prop, err := c.BuildProposal([]ProtoMessage{msg}, title, title, "", deposit, proposer, false)
+	if err != nil {
+		return TxProposal{}, err
+	}
+
+	return c.SubmitProposal(ctx, keyName, prop)
+}
@@ -0,0 +1,412 @@
+package cosmos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/strangelove-ventures/interchaintest/v8/testutil"
+	"golang.org/x/sync/errgroup"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// proposalTallyBlocks is how many blocks past the end of the voting period
+// a proposal may take to be tallied before waiting for it fails.
+const proposalTallyBlocks = 10
+
+// ProposalVote is the vote a single validator casts on a proposal.
+type ProposalVote struct {
+	// Option is one of ProposalVoteYes, ProposalVoteNo, ProposalVoteNoWithVeto or ProposalVoteAbstain.
+	Option string
+
+	// If set, a weighted vote is cast instead of Option, e.g. "yes=0.6,no=0.4".
+	Weighted string
+}
+
+// ProposalOptions configures PassProposal and FailProposal.
+type ProposalOptions struct {
+	// Key of the proposer, which also pays any remaining deposit. Required.
+	KeyName string
+
+	Title    string
+	Summary  string
+	Metadata string
+
+	// Initial deposit, e.g. "10000000stake". If empty, the minimum deposit is used.
+	// If the initial deposit is below the minimum, the remainder is deposited by KeyName.
+	Deposit string
+
+	Expedited bool
+
+	// Voting plan mapping an index into CosmosChain.Validators to that validator's vote.
+	// Validators without an entry cast DefaultVote.
+	Votes map[int]ProposalVote
+
+	// Vote cast by validators missing from Votes. If zero, PassProposal votes yes and FailProposal votes no.
+	DefaultVote ProposalVote
+
+	// If set, the proposal is submitted as a v1beta1 text proposal through TextProposal
+	// for chains without gov v1. The msgs passed alongside must be empty.
+	Legacy bool
+}
+
+// ProposalResult is the outcome of a proposal once its voting period ended.
+type ProposalResult struct {
+	ProposalID uint64
+	Tx         TxProposal
+	Status     govv1.ProposalStatus
+	Tally      *govv1.TallyResult
+
+	// Height of the block that tallied the proposal, zero if it could not be found.
+	Height int64
+
+	// Events of the block that tallied the proposal,
+	// including those emitted by the msgs of a passed proposal.
+	Events []abcitypes.Event
+
+	// Why the msgs of the proposal failed to execute, if they did.
+	FailedReason string
+}
+
+// PassProposal submits a proposal with msgs, deposits, votes according to opts and waits for the end
+// of its voting period. It returns an error if the proposal did not pass and execute its msgs,
+// in which case the returned result still describes the outcome.
+func (c *CosmosChain) PassProposal(ctx context.Context, msgs []ProtoMessage, opts ProposalOptions) (*ProposalResult, error) {
+	if opts.DefaultVote == (ProposalVote{}) {
+		opts.DefaultVote = ProposalVote{Option: ProposalVoteYes}
+	}
+	res, err := c.runProposal(ctx, msgs, opts)
+	if err != nil {
+		return res, err
+	}
+	if res.Status != govv1.StatusPassed {
+		return res, fmt.Errorf("proposal %d did not pass: status %s, tally %s, reason %q", res.ProposalID, res.Status, res.Tally, res.FailedReason)
+	}
+	return res, nil
+}
+
+// FailProposal is like PassProposal, but expects the proposal to be rejected
+// or to fail executing its msgs, and defaults to voting no.
+func (c *CosmosChain) FailProposal(ctx context.Context, msgs []ProtoMessage, opts ProposalOptions) (*ProposalResult, error) {
+	if opts.DefaultVote == (ProposalVote{}) {
+		opts.DefaultVote = ProposalVote{Option: ProposalVoteNo}
+	}
+	res, err := c.runProposal(ctx, msgs, opts)
+	if err != nil {
+		return res, err
+	}
+	if res.Status != govv1.StatusRejected && res.Status != govv1.StatusFailed {
+		return res, fmt.Errorf("proposal %d did not fail: status %s, tally %s", res.ProposalID, res.Status, res.Tally)
+	}
+	return res, nil
+}
+
+func (c *CosmosChain) runProposal(ctx context.Context, msgs []ProtoMessage, opts ProposalOptions) (*ProposalResult, error) {
+	if opts.KeyName == "" {
+		return nil, errors.New("proposal options must set a key name")
+	}
+
+	deposit := opts.Deposit
+	if deposit == "" {
+		minDeposit, err := c.minProposalDeposit(ctx, opts.Expedited)
+		if err != nil {
+			return nil, err
+		}
+		deposit = minDeposit.String()
+	}
+
+	tx, err := c.submitProposal(ctx, msgs, deposit, opts)
+	if err != nil {
+		return nil, err
+	}
+	proposalID, err := strconv.ParseUint(tx.ProposalID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse proposal ID %q: %w", tx.ProposalID, err)
+	}
+	res := &ProposalResult{ProposalID: proposalID, Tx: tx}
+
+	if err := c.completeProposalDeposit(ctx, opts.KeyName, proposalID, opts.Expedited); err != nil {
+		return res, err
+	}
+
+	if err := c.voteOnProposal(ctx, proposalID, opts); err != nil {
+		return res, err
+	}
+
+	state, err := c.waitForProposalTally(ctx, proposalID)
+	if err != nil {
+		return res, err
+	}
+	res.Status = state.status
+	res.Tally = state.tally
+	res.FailedReason = state.failedReason
+
+	if err := c.findProposalTally(ctx, res); err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+func (c *CosmosChain) submitProposal(ctx context.Context, msgs []ProtoMessage, deposit string, opts ProposalOptions) (TxProposal, error) {
+	if opts.Legacy {
+		if len(msgs) > 0 {
+			return TxProposal{}, errors.New("legacy proposals cannot contain msgs")
+		}
+		return c.TextProposal(ctx, opts.KeyName, TextProposal{
+			Deposit:     deposit,
+			Title:       opts.Title,
+			Description: opts.Summary,
+			Expedited:   opts.Expedited,
+		})
+	}
+
+	proposer, err := c.getFullNode().AccountKeyBech32(ctx, opts.KeyName)
+	if err != nil {
+		return TxProposal{}, fmt.Errorf("failed to get proposer address: %w", err)
+	}
+	prop, err := c.BuildProposal(msgs, opts.Title, opts.Summary, opts.Metadata, deposit, proposer, opts.Expedited)
+	if err != nil {
+		return TxProposal{}, err
+	}
+	return c.SubmitProposal(ctx, opts.KeyName, prop)
+}
+
+// minProposalDeposit returns the deposit a proposal needs to enter its voting period.
+// Chains without gov v1 have no expedited proposals, so their regular minimum deposit is returned.
+func (c *CosmosChain) minProposalDeposit(ctx context.Context, expedited bool) (sdk.Coins, error) {
+	params, err := c.GovQueryParams(ctx, "deposit")
+	if err == nil {
+		if expedited {
+			return sdk.NewCoins(params.ExpeditedMinDeposit...), nil
+		}
+		return sdk.NewCoins(params.MinDeposit...), nil
+	}
+	if status.Code(err) != codes.Unimplemented {
+		return nil, fmt.Errorf("failed to query gov deposit params: %w", err)
+	}
+
+	legacy, err := govv1beta1.NewQueryClient(c.GetNode().GrpcConn).Params(ctx, &govv1beta1.QueryParamsRequest{
+		ParamsType: "deposit",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query v1beta1 gov deposit params: %w", err)
+	}
+	return sdk.NewCoins(legacy.DepositParams.MinDeposit...), nil
+}
+
+// completeProposalDeposit tops up the deposit of a proposal still in its deposit period.
+func (c *CosmosChain) completeProposalDeposit(ctx context.Context, keyName string, proposalID uint64, expedited bool) error {
+	state, err := c.queryProposalState(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+	if state.status != govv1.StatusDepositPeriod {
+		return nil
+	}
+
+	minDeposit, err := c.minProposalDeposit(ctx, expedited)
+	if err != nil {
+		return err
+	}
+	remaining := remainingDeposit(minDeposit, state.totalDeposit)
+	if remaining.IsZero() {
+		return nil
+	}
+	if err := c.getFullNode().DepositOnProposal(ctx, keyName, proposalID, remaining.String()); err != nil {
+		return fmt.Errorf("failed to deposit %s on proposal %d: %w", remaining, proposalID, err)
+	}
+	return nil
+}
+
+// remainingDeposit returns the part of minDeposit not yet covered by deposited.
+func remainingDeposit(minDeposit, deposited sdk.Coins) sdk.Coins {
+	remaining := sdk.NewCoins()
+	for _, coin := range minDeposit {
+		if diff := coin.Amount.Sub(deposited.AmountOf(coin.Denom)); diff.IsPositive() {
+			remaining = remaining.Add(sdk.NewCoin(coin.Denom, diff))
+		}
+	}
+	return remaining
+}
+
+// voteOnProposal casts the vote of every validator according to the voting plan in opts.
+func (c *CosmosChain) voteOnProposal(ctx context.Context, proposalID uint64, opts ProposalOptions) error {
+	for i := range opts.Votes {
+		if i < 0 || i >= len(c.Validators) {
+			return fmt.Errorf("voting plan references validator %d, but the chain has %d validators", i, len(c.Validators))
+		}
+	}
+
+	var eg errgroup.Group
+	for i, n := range c.Validators {
+		n := n
+		vote, ok := opts.Votes[i]
+		if !ok {
+			vote = opts.DefaultVote
+		}
+		eg.Go(func() error {
+			if vote.Weighted != "" {
+				return n.WeightedVoteOnProposal(ctx, valKey, proposalID, vote.Weighted)
+			}
+			return n.VoteOnProposal(ctx, valKey, proposalID, vote.Option)
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return fmt.Errorf("failed to vote on proposal %d: %w", proposalID, err)
+	}
+	return nil
+}
+
+// proposalState is the part of a proposal shared by gov v1 and v1beta1.
+type proposalState struct {
+	status       govv1.ProposalStatus
+	tally        *govv1.TallyResult
+	totalDeposit sdk.Coins
+	votingEnd    *time.Time
+	failedReason string
+}
+
+func (s proposalState) tallied() bool {
+	switch s.status {
+	case govv1.StatusPassed, govv1.StatusRejected, govv1.StatusFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// queryProposalState queries a proposal through gov v1,
+// falling back to v1beta1 on chains which do not serve v1.
+func (c *CosmosChain) queryProposalState(ctx context.Context, proposalID uint64) (proposalState, error) {
+	p, err := c.GovQueryProposalV1(ctx, proposalID)
+	if err == nil {
+		return proposalState{
+			status:       p.Status,
+			tally:        p.FinalTallyResult,
+			totalDeposit: sdk.NewCoins(p.TotalDeposit...),
+			votingEnd:    p.VotingEndTime,
+			failedReason: p.FailedReason,
+		}, nil
+	}
+	if status.Code(err) != codes.Unimplemented {
+		return proposalState{}, fmt.Errorf("failed to query proposal %d: %w", proposalID, err)
+	}
+
+	legacy, err := c.GovQueryProposal(ctx, proposalID)
+	if err != nil {
+		return proposalState{}, fmt.Errorf("failed to query v1beta1 proposal %d: %w", proposalID, err)
+	}
+	return legacyProposalState(legacy), nil
+}
+
+func legacyProposalState(p *govv1beta1.Proposal) proposalState {
+	state := proposalState{
+		status: govv1.ProposalStatus(p.Status),
+		tally: &govv1.TallyResult{
+			YesCount:        p.FinalTallyResult.Yes.String(),
+			AbstainCount:    p.FinalTallyResult.Abstain.String(),
+			NoCount:         p.FinalTallyResult.No.String(),
+			NoWithVetoCount: p.FinalTallyResult.NoWithVeto.String(),
+		},
+		totalDeposit: p.TotalDeposit,
+	}
+	if !p.VotingEndTime.IsZero() {
+		end := p.VotingEndTime
+		state.votingEnd = &end
+	}
+	return state
+}
+
+// waitForProposalTally waits until the voting period of a proposal ended and it was tallied.
+// Expedited proposals which do not pass are converted to regular proposals with a later voting end,
+// so the end time is re-read on every iteration.
+func (c *CosmosChain) waitForProposalTally(ctx context.Context, proposalID uint64) (proposalState, error) {
+	var blocksPastEnd int
+	for {
+		state, err := c.queryProposalState(ctx, proposalID)
+		if err != nil {
+			return state, err
+		}
+		if state.tallied() {
+			return state, nil
+		}
+
+		if state.votingEnd != nil {
+			if wait := time.Until(*state.votingEnd); wait > 0 {
+				select {
+				case <-ctx.Done():
+					return state, ctx.Err()
+				case <-time.After(wait):
+				}
+				continue
+			}
+		}
+
+		if blocksPastEnd >= proposalTallyBlocks {
+			return state, fmt.Errorf("proposal %d still has status %s %d blocks after its voting period", proposalID, state.status, blocksPastEnd)
+		}
+		if err := testutil.WaitForBlocks(ctx, 1, c); err != nil {
+			return state, err
+		}
+		blocksPastEnd++
+	}
+}
+
+// findProposalTally searches the blocks since the proposal was submitted, newest first,
+// for the one that tallied it, and records that block's events on res.
+func (c *CosmosChain) findProposalTally(ctx context.Context, res *ProposalResult) error {
+	height, err := c.Height(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get chain height: %w", err)
+	}
+
+	id := strconv.FormatUint(res.ProposalID, 10)
+	for h := height; h > res.Tx.Height; h-- {
+		h := h
+		blockRes, err := c.getFullNode().Client.BlockResults(ctx, &h)
+		if err != nil {
+			return fmt.Errorf("failed to get block results at height %d: %w", h, err)
+		}
+		reason, ok := proposalTallyLog(blockRes.FinalizeBlockEvents, id)
+		if !ok {
+			continue
+		}
+		res.Height = h
+		res.Events = blockRes.FinalizeBlockEvents
+		if res.FailedReason == "" && res.Status == govv1.StatusFailed {
+			res.FailedReason = reason
+		}
+		return nil
+	}
+	return nil
+}
+
+// proposalTallyLog reports whether events contain the tally of proposalID, and returns its proposal log.
+func proposalTallyLog(events []abcitypes.Event, proposalID string) (string, bool) {
+	for _, e := range events {
+		if e.Type != govtypes.EventTypeActiveProposal {
+			continue
+		}
+		var id, log string
+		for _, attr := range e.Attributes {
+			switch attr.Key {
+			case govtypes.AttributeKeyProposalID:
+				id = attr.Value
+			case govtypes.AttributeKeyProposalLog:
+				log = attr.Value
+			}
+		}
+		if id == proposalID {
+			return log, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,110 @@
+package cosmos
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestRemainingDeposit(t *testing.T) {
+	minDeposit := sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("uatom", 10))
+
+	remaining := remainingDeposit(minDeposit, sdk.NewCoins(sdk.NewInt64Coin("stake", 40)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 60), sdk.NewInt64Coin("uatom", 10)), remaining)
+
+	remaining = remainingDeposit(minDeposit, sdk.NewCoins(sdk.NewInt64Coin("stake", 500), sdk.NewInt64Coin("uatom", 10)))
+	require.True(t, remaining.IsZero())
+}
+
+// legacyGovQueryServer serves only the v1beta1 gov queries, like a chain without gov v1.
+type legacyGovQueryServer struct {
+	*govv1beta1.UnimplementedQueryServer
+	minDeposit sdk.Coins
+}
+
+func (s legacyGovQueryServer) Params(context.Context, *govv1beta1.QueryParamsRequest) (*govv1beta1.QueryParamsResponse, error) {
+	return &govv1beta1.QueryParamsResponse{DepositParams: govv1beta1.DepositParams{MinDeposit: s.minDeposit}}, nil
+}
+
+func TestMinProposalDeposit_Legacy(t *testing.T) {
+	minDeposit := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	govv1beta1.RegisterQueryServer(srv, legacyGovQueryServer{minDeposit: minDeposit})
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	c := &CosmosChain{Validators: ChainNodes{{GrpcConn: conn}}}
+	ctx := context.Background()
+
+	deposit, err := c.minProposalDeposit(ctx, false)
+	require.NoError(t, err)
+	require.Equal(t, minDeposit, deposit)
+
+	// v1beta1 has no expedited proposals, so the regular minimum applies.
+	deposit, err = c.minProposalDeposit(ctx, true)
+	require.NoError(t, err)
+	require.Equal(t, minDeposit, deposit)
+}
+
+func TestProposalTallyLog(t *testing.T) {
+	events := []abcitypes.Event{
+		{Type: "transfer", Attributes: []abcitypes.EventAttribute{{Key: "proposal_id", Value: "3"}}},
+		{Type: "active_proposal", Attributes: []abcitypes.EventAttribute{
+			{Key: "proposal_id", Value: "3"},
+			{Key: "proposal_result", Value: "proposal_failed"},
+			{Key: "proposal_log", Value: "proposal execution failed on proposal 3, because of error insufficient funds"},
+		}},
+	}
+
+	log, ok := proposalTallyLog(events, "3")
+	require.True(t, ok)
+	require.Contains(t, log, "insufficient funds")
+
+	_, ok = proposalTallyLog(events, "4")
+	require.False(t, ok)
+}
+
+func TestLegacyProposalState(t *testing.T) {
+	end := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	state := legacyProposalState(&govv1beta1.Proposal{
+		Status: govv1beta1.StatusPassed,
+		FinalTallyResult: govv1beta1.TallyResult{
+			Yes:        sdkmath.NewInt(3),
+			Abstain:    sdkmath.ZeroInt(),
+			No:         sdkmath.NewInt(1),
+			NoWithVeto: sdkmath.ZeroInt(),
+		},
+		TotalDeposit:  sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		VotingEndTime: end,
+	})
+
+	require.Equal(t, govv1.StatusPassed, state.status)
+	require.True(t, state.tallied())
+	require.Equal(t, "3", state.tally.YesCount)
+	require.Equal(t, "1", state.tally.NoCount)
+	require.Equal(t, end, *state.votingEnd)
+
+	state = legacyProposalState(&govv1beta1.Proposal{Status: govv1beta1.StatusDepositPeriod})
+	require.False(t, state.tallied())
+	require.Nil(t, state.votingEnd)
+}
@@ -0,0 +1,83 @@
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	libclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"go.uber.org/zap"
+
+	"github.com/strangelove-ventures/interchaintest/v8/blockdb"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/internal/tendermint"
+)
+
+// RPCBlockFinder finds the blocks of a chain through the CometBFT RPC of any of its nodes, e.g. of a local-ic chain
+// or a devnet which was not started by interchaintest, so that they can be collected into a blockdb.
+// Txs are decoded with the interface registry.
+type RPCBlockFinder struct {
+	log               *zap.Logger
+	remote            string
+	client            rpcclient.Client
+	interfaceRegistry codectypes.InterfaceRegistry
+}
+
+var (
+	_ blockdb.BlockFinder   = (*RPCBlockFinder)(nil)
+	_ blockdb.TxFinder      = (*RPCBlockFinder)(nil)
+	_ blockdb.BlockNotifier = (*RPCBlockFinder)(nil)
+)
+
+// NewRPCBlockFinder returns a RPCBlockFinder for the node with the CometBFT RPC address remote,
+// e.g. http://localhost:26657.
+func NewRPCBlockFinder(log *zap.Logger, remote string, interfaceRegistry codectypes.InterfaceRegistry) (*RPCBlockFinder, error) {
+	httpClient, err := libclient.DefaultHTTPClient(remote)
+	if err != nil {
+		return nil, err
+	}
+	httpClient.Timeout = 10 * time.Second
+	client, err := rpchttp.NewWithClient(remote, "/websocket", httpClient)
+	if err != nil {
+		return nil, err
+	}
+	return &RPCBlockFinder{
+		log:               log,
+		remote:            remote,
+		client:            client,
+		interfaceRegistry: interfaceRegistry,
+	}, nil
+}
+
+// Status returns the status of the node, with the chain ID and its earliest and latest block heights.
+func (f *RPCBlockFinder) Status(ctx context.Context) (*coretypes.ResultStatus, error) {
+	status, err := f.client.Status(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("status: %w", err)
+	}
+	return status, nil
+}
+
+// FindTxs implements blockdb.TxFinder.
+func (f *RPCBlockFinder) FindTxs(ctx context.Context, height int64) ([]blockdb.Tx, error) {
+	block, err := f.FindBlock(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	return blockTxs(block), nil
+}
+
+// FindBlock implements blockdb.BlockFinder.
+func (f *RPCBlockFinder) FindBlock(ctx context.Context, height int64) (blockdb.Block, error) {
+	return tendermint.FindBlock(ctx, f.log, f.client, height, func(tx []byte) ([]byte, error) {
+		return txToJSON(f.interfaceRegistry, tx)
+	})
+}
+
+// NotifyNewBlocks implements blockdb.BlockNotifier.
+func (f *RPCBlockFinder) NotifyNewBlocks(ctx context.Context) (<-chan int64, error) {
+	return tendermint.NotifyNewBlocks(ctx, f.log, f.remote, newBlocksStaleAfter)
+}
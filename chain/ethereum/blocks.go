@@ -0,0 +1,151 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/strangelove-ventures/interchaintest/v8/blockdb"
+)
+
+var (
+	_ blockdb.TxFinder    = (*EthereumChain)(nil)
+	_ blockdb.BlockFinder = (*EthereumChain)(nil)
+	_ io.Closer           = (*EthereumChain)(nil)
+)
+
+// ethBlock holds the fields of an eth_getBlockByNumber result which are saved apart from the txs.
+type ethBlock struct {
+	Timestamp    hexutil.Uint64    `json:"timestamp"`
+	Miner        string            `json:"miner"`
+	StateRoot    string            `json:"stateRoot"`
+	Transactions []json.RawMessage `json:"transactions"`
+}
+
+type ethTx struct {
+	Hash string         `json:"hash"`
+	Gas  hexutil.Uint64 `json:"gas"`
+}
+
+type ethReceipt struct {
+	Status  hexutil.Uint64 `json:"status"`
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+	Logs    []ethLog       `json:"logs"`
+}
+
+type ethLog struct {
+	Address string   `json:"address"`
+	Topics  []string `json:"topics"`
+	Data    string   `json:"data"`
+}
+
+// FindTxs implements blockdb.TxFinder.
+func (c *EthereumChain) FindTxs(ctx context.Context, height int64) ([]blockdb.Tx, error) {
+	block, err := c.FindBlock(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	return block.Txs, nil
+}
+
+// FindBlock implements blockdb.BlockFinder through the JSON-RPC API of the chain.
+// Txs are saved as returned by eth_getBlockByNumber, with the logs of their receipts as events.
+// A reverted tx is saved with code 1.
+func (c *EthereumChain) FindBlock(ctx context.Context, height int64) (blockdb.Block, error) {
+	client, err := c.jsonRPCClient(ctx)
+	if err != nil {
+		return blockdb.Block{}, err
+	}
+
+	var raw json.RawMessage
+	if err := client.CallContext(ctx, &raw, "eth_getBlockByNumber", hexutil.EncodeUint64(uint64(height)), true); err != nil {
+		return blockdb.Block{}, fmt.Errorf("eth_getBlockByNumber: %w", err)
+	}
+	if len(raw) == 0 || string(raw) == "null" {
+		return blockdb.Block{}, fmt.Errorf("block %d: %w", height, blockdb.ErrFutureHeight)
+	}
+	var block ethBlock
+	if err := json.Unmarshal(raw, &block); err != nil {
+		return blockdb.Block{}, fmt.Errorf("parse block %d: %w", height, err)
+	}
+
+	txs := make([]blockdb.Tx, len(block.Transactions))
+	for i, rawTx := range block.Transactions {
+		var tx ethTx
+		if err := json.Unmarshal(rawTx, &tx); err != nil {
+			return blockdb.Block{}, fmt.Errorf("parse tx %d of block %d: %w", i, height, err)
+		}
+		var receipt ethReceipt
+		if err := client.CallContext(ctx, &receipt, "eth_getTransactionReceipt", tx.Hash); err != nil {
+			return blockdb.Block{}, fmt.Errorf("eth_getTransactionReceipt %s: %w", tx.Hash, err)
+		}
+
+		txs[i] = blockdb.Tx{
+			Data:      rawTx,
+			Events:    logEvents(receipt.Logs),
+			GasUsed:   int64(receipt.GasUsed),
+			GasWanted: int64(tx.Gas),
+		}
+		if receipt.Status == 0 {
+			txs[i].Code = 1
+			txs[i].Log = "transaction reverted"
+		}
+	}
+
+	return blockdb.Block{
+		Height: height,
+		Header: blockdb.BlockHeader{
+			Time:            time.Unix(int64(block.Timestamp), 0),
+			ProposerAddress: block.Miner,
+			AppHash:         block.StateRoot,
+		},
+		Txs: txs,
+	}, nil
+}
+
+// logEvents converts the logs of a receipt to events, with the topics as numbered attributes.
+func logEvents(logs []ethLog) []blockdb.Event {
+	if len(logs) == 0 {
+		return nil
+	}
+	events := make([]blockdb.Event, len(logs))
+	for i, l := range logs {
+		attrs := []blockdb.EventAttribute{{Key: "address", Value: l.Address}}
+		for j, topic := range l.Topics {
+			attrs = append(attrs, blockdb.EventAttribute{Key: "topic" + strconv.Itoa(j), Value: topic})
+		}
+		attrs = append(attrs, blockdb.EventAttribute{Key: "data", Value: l.Data})
+		events[i] = blockdb.Event{Type: "log", Attributes: attrs}
+	}
+	return events
+}
+
+func (c *EthereumChain) jsonRPCClient(ctx context.Context) (*rpc.Client, error) {
+	c.rpcMu.Lock()
+	defer c.rpcMu.Unlock()
+	if c.rpcClient == nil {
+		client, err := rpc.DialContext(ctx, c.GetHostRPCAddress())
+		if err != nil {
+			return nil, fmt.Errorf("dial %s: %w", c.GetHostRPCAddress(), err)
+		}
+		c.rpcClient = client
+	}
+	return c.rpcClient, nil
+}
+
+// Close closes the JSON-RPC client used by FindBlock. It is called when the blocks of the chain are no longer collected.
+// A later FindBlock dials a new client.
+func (c *EthereumChain) Close() error {
+	c.rpcMu.Lock()
+	defer c.rpcMu.Unlock()
+	if c.rpcClient != nil {
+		c.rpcClient.Close()
+		c.rpcClient = nil
+	}
+	return nil
+}
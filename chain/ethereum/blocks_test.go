@@ -0,0 +1,79 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/strangelove-ventures/interchaintest/v8/blockdb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEthereumChain_FindBlock(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+			Params []any           `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		var result string
+		switch {
+		case req.Method == "eth_getBlockByNumber" && req.Params[0] == "0x2":
+			result = `{"number":"0x2","timestamp":"0x66324ac0","miner":"0x0000000000000000000000000000000000000000","stateRoot":"0xabcd","transactions":[
+				{"hash":"0x01","from":"0xf39f","to":"0x7099","gas":"0x5208","value":"0x1"},
+				{"hash":"0x02","from":"0xf39f","to":"0x5fbd","gas":"0x30000"}
+			]}`
+		case req.Method == "eth_getBlockByNumber":
+			result = `null`
+		case req.Method == "eth_getTransactionReceipt" && req.Params[0] == "0x01":
+			result = `{"status":"0x1","gasUsed":"0x5208","logs":[]}`
+		case req.Method == "eth_getTransactionReceipt":
+			result = `{"status":"0x0","gasUsed":"0x1000","logs":[{"address":"0x5fbd","topics":["0xddf2","0x0001"],"data":"0x2a"}]}`
+		default:
+			t.Errorf("unexpected method %s", req.Method)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":` + result + `}`))
+	}))
+	defer srv.Close()
+
+	c := &EthereumChain{hostRPCPort: strings.TrimPrefix(srv.URL, "http://")}
+	ctx := context.Background()
+
+	block, err := c.FindBlock(ctx, 2)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, block.Height)
+	require.EqualValues(t, 0x66324ac0, block.Header.Time.Unix())
+	require.Equal(t, "0xabcd", block.Header.AppHash)
+	require.Len(t, block.Txs, 2)
+
+	transfer := block.Txs[0]
+	require.Contains(t, string(transfer.Data), `"hash":"0x01"`)
+	require.EqualValues(t, 0x5208, transfer.GasUsed)
+	require.EqualValues(t, 0x5208, transfer.GasWanted)
+	require.Zero(t, transfer.Code)
+	require.Empty(t, transfer.Events)
+
+	reverted := block.Txs[1]
+	require.EqualValues(t, 1, reverted.Code)
+	require.Equal(t, []blockdb.Event{{Type: "log", Attributes: []blockdb.EventAttribute{
+		{Key: "address", Value: "0x5fbd"},
+		{Key: "topic0", Value: "0xddf2"},
+		{Key: "topic1", Value: "0x0001"},
+		{Key: "data", Value: "0x2a"},
+	}}}, reverted.Events)
+
+	_, err = c.FindBlock(ctx, 3)
+	require.ErrorIs(t, err, blockdb.ErrFutureHeight)
+
+	require.NoError(t, c.Close())
+	require.Nil(t, c.rpcClient)
+	_, err = c.FindBlock(ctx, 2)
+	require.NoError(t, err)
+	require.NoError(t, c.Close())
+}
@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 
 	sdkmath "cosmossdk.io/math"
 	dockertypes "github.com/docker/docker/api/types"
@@ -17,6 +18,7 @@ import (
 	"github.com/docker/docker/api/types/volume"
 	dockerclient "github.com/docker/docker/client"
 	"github.com/docker/go-connections/nat"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/strangelove-ventures/interchaintest/v8/dockerutil"
 	"github.com/strangelove-ventures/interchaintest/v8/ibc"
 	"github.com/strangelove-ventures/interchaintest/v8/testutil"
@@ -53,6 +55,10 @@ type EthereumChain struct {
 	genesisWallets GenesisWallets
 
 	keystoreMap map[string]string
+
+	// JSON-RPC client of the host RPC address, created on first use by FindBlock.
+	rpcMu     sync.Mutex
+	rpcClient *rpc.Client
 }
 
 func DefaultEthereumAnvilChainConfig(
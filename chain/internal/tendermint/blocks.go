@@ -0,0 +1,178 @@
+package tendermint
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/strangelove-ventures/interchaintest/v8/blockdb"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// TxDecoder decodes a raw tx into a human-readable payload, preferably JSON.
+// If the tx is only partly decoded, e.g. because a message type is unknown, it returns the partly decoded payload
+// along with the error.
+type TxDecoder func(tx []byte) ([]byte, error)
+
+// FindBlock finds the block at height through the CometBFT RPC client, for blockdb.
+// Txs are decoded with decodeTx, and saved along with their raw bytes and decoding error, if any.
+// Txs which fail to decode at all are saved hex encoded.
+// Heights past the latest block reported by the node's status return blockdb.ErrFutureHeight.
+func FindBlock(ctx context.Context, log *zap.Logger, client rpcclient.Client, height int64, decodeTx TxDecoder) (blockdb.Block, error) {
+	status, err := client.Status(ctx)
+	if err != nil {
+		return blockdb.Block{}, fmt.Errorf("status: %w", err)
+	}
+	if latest := status.SyncInfo.LatestBlockHeight; height > latest {
+		return blockdb.Block{}, fmt.Errorf("block %d past latest height %d: %w", height, latest, blockdb.ErrFutureHeight)
+	}
+
+	var eg errgroup.Group
+	var blockRes *coretypes.ResultBlockResults
+	var block *coretypes.ResultBlock
+	eg.Go(func() (err error) {
+		blockRes, err = client.BlockResults(ctx, &height)
+		return err
+	})
+	eg.Go(func() (err error) {
+		block, err = client.Block(ctx, &height)
+		return err
+	})
+	if err := eg.Wait(); err != nil {
+		// The node may be behind the one which reported its status, e.g. behind a load balancer,
+		// so still match the error of a height it has not reached.
+		if strings.Contains(err.Error(), "must be less than or equal to the current blockchain height") {
+			return blockdb.Block{}, fmt.Errorf("%w: %v", blockdb.ErrFutureHeight, err)
+		}
+		return blockdb.Block{}, err
+	}
+
+	txs := make([]blockdb.Tx, 0, len(block.Block.Txs))
+	for i, tx := range block.Block.Txs {
+		newTx := blockdb.Tx{
+			Data: []byte(fmt.Sprintf(`{"data":"%s"}`, hex.EncodeToString(tx))),
+			Raw:  tx,
+		}
+		b, err := decodeTx(tx)
+		if err != nil {
+			log.Info("Failed to decode tx", zap.Int64("height", height), zap.Error(err))
+			newTx.DecodeError = err.Error()
+		}
+		if len(b) > 0 {
+			newTx.Data = b
+		}
+
+		if i < len(blockRes.TxsResults) {
+			rTx := blockRes.TxsResults[i]
+			newTx.GasUsed, newTx.GasWanted = rTx.GasUsed, rTx.GasWanted
+			newTx.Code, newTx.Codespace, newTx.Log = rTx.Code, rTx.Codespace, rTx.Log
+			newTx.Events = BlockdbEvents(rTx.Events)
+		}
+		txs = append(txs, newTx)
+	}
+
+	header := block.Block.Header
+	return blockdb.Block{
+		Height: height,
+		Header: blockdb.BlockHeader{
+			Time:            header.Time,
+			ProposerAddress: header.ProposerAddress.String(),
+			AppHash:         header.AppHash.String(),
+			ValidatorsHash:  header.ValidatorsHash.String(),
+		},
+		Txs:    txs,
+		Events: BlockdbEvents(blockRes.FinalizeBlockEvents),
+	}, nil
+}
+
+// NotifyNewBlocks subscribes to the new blocks of the node with the CometBFT RPC address remote, for blockdb.
+// It uses its own websocket connection, which is closed with the returned channel.
+//
+// The channel is also closed if no block was received for staleAfter, because the websocket client
+// keeps trying to reconnect to a node which restarted on a different host port instead of failing.
+func NotifyNewBlocks(ctx context.Context, log *zap.Logger, remote string, staleAfter time.Duration) (<-chan int64, error) {
+	client, err := rpchttp.New(remote, "/websocket")
+	if err != nil {
+		return nil, err
+	}
+	if err := client.Start(); err != nil {
+		return nil, fmt.Errorf("start websocket client: %w", err)
+	}
+	const query = "tm.event='NewBlockHeader'"
+	events, err := client.Subscribe(ctx, "blockdb", query)
+	if err != nil {
+		_ = client.Stop()
+		return nil, fmt.Errorf("subscribe to %s: %w", query, err)
+	}
+
+	heights := make(chan int64)
+	go func() {
+		defer close(heights)
+		defer func() {
+			// The subscription context is done, so use a short-lived one to unsubscribe.
+			unsubCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			_ = client.UnsubscribeAll(unsubCtx, "blockdb")
+			_ = client.Stop()
+		}()
+
+		stale := time.NewTimer(staleAfter)
+		defer stale.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-client.Quit():
+				return
+			case <-stale.C:
+				log.Debug("No new block notified, ending subscription", zap.Duration("stale_after", staleAfter))
+				return
+			case ev := <-events:
+				header, ok := ev.Data.(cmttypes.EventDataNewBlockHeader)
+				if !ok {
+					continue
+				}
+				if !stale.Stop() {
+					<-stale.C
+				}
+				stale.Reset(staleAfter)
+				select {
+				case <-ctx.Done():
+					return
+				case heights <- header.Header.Height:
+				}
+			}
+		}
+	}()
+	return heights, nil
+}
+
+// BlockdbEvents converts ABCI events to their blockdb representation.
+func BlockdbEvents(events []abcitypes.Event) []blockdb.Event {
+	if len(events) == 0 {
+		return nil
+	}
+	converted := make([]blockdb.Event, len(events))
+	for i, e := range events {
+		attrs := make([]blockdb.EventAttribute, len(e.Attributes))
+		for j, attr := range e.Attributes {
+			attrs[j] = blockdb.EventAttribute{
+				Key:   attr.Key,
+				Value: attr.Value,
+			}
+		}
+		converted[i] = blockdb.Event{
+			Type:       e.Type,
+			Attributes: attrs,
+		}
+	}
+	return converted
+}
@@ -0,0 +1,64 @@
+package tendermint
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/strangelove-ventures/interchaintest/v8/blockdb"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// errFutureHeight is returned by both the block and block results endpoints of a node.
+var errFutureHeight = errors.New("height 3 must be less than or equal to the current blockchain height 2")
+
+// blocksClient serves blocks up to latest, failing like a node for greater heights.
+type blocksClient struct {
+	rpcclient.Client
+	latest, reached int64
+}
+
+func (c blocksClient) Status(context.Context) (*coretypes.ResultStatus, error) {
+	return &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: c.latest}}, nil
+}
+
+func (c blocksClient) Block(_ context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	if *height > c.reached {
+		return nil, errFutureHeight
+	}
+	return &coretypes.ResultBlock{Block: &cmttypes.Block{Header: cmttypes.Header{Height: *height}}}, nil
+}
+
+func (c blocksClient) BlockResults(_ context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+	if *height > c.reached {
+		return nil, errFutureHeight
+	}
+	return &coretypes.ResultBlockResults{
+		Height:              *height,
+		FinalizeBlockEvents: []abcitypes.Event{{Type: "block"}},
+	}, nil
+}
+
+func TestFindBlock(t *testing.T) {
+	ctx := context.Background()
+	decode := func(tx []byte) ([]byte, error) { return tx, nil }
+
+	client := blocksClient{latest: 2, reached: 2}
+	block, err := FindBlock(ctx, zap.NewNop(), client, 2, decode)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, block.Height)
+	require.Len(t, block.Events, 1)
+
+	_, err = FindBlock(ctx, zap.NewNop(), client, 3, decode)
+	require.ErrorIs(t, err, blockdb.ErrFutureHeight)
+
+	// A node behind the reported status still fails with ErrFutureHeight.
+	client = blocksClient{latest: 3, reached: 2}
+	_, err = FindBlock(ctx, zap.NewNop(), client, 3, decode)
+	require.ErrorIs(t, err, blockdb.ErrFutureHeight)
+}
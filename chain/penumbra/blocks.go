@@ -0,0 +1,44 @@
+package penumbra
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/cosmos/gogoproto/jsonpb"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/strangelove-ventures/interchaintest/v8/blockdb"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/internal/tendermint"
+	transactionv1alpha1 "github.com/strangelove-ventures/interchaintest/v8/chain/penumbra/core/transaction/v1alpha1"
+)
+
+var (
+	_ blockdb.TxFinder    = (*PenumbraChain)(nil)
+	_ blockdb.BlockFinder = (*PenumbraChain)(nil)
+)
+
+// FindTxs implements blockdb.TxFinder.
+func (c *PenumbraChain) FindTxs(ctx context.Context, height int64) ([]blockdb.Tx, error) {
+	block, err := c.FindBlock(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	return block.Txs, nil
+}
+
+// FindBlock implements blockdb.BlockFinder through the CometBFT RPC of the first validator.
+// Txs are saved as the JSON of their penumbra protobuf, or hex encoded if they do not decode.
+func (c *PenumbraChain) FindBlock(ctx context.Context, height int64) (blockdb.Block, error) {
+	return tendermint.FindBlock(ctx, c.log, c.getFullNode().TendermintNode.Client, height, decodeTx)
+}
+
+func decodeTx(tx []byte) ([]byte, error) {
+	var msg transactionv1alpha1.Transaction
+	if err := proto.Unmarshal(tx, &msg); err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := (&jsonpb.Marshaler{}).Marshal(&buf, &msg); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,21 @@
+package penumbra
+
+import (
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	transactionv1alpha1 "github.com/strangelove-ventures/interchaintest/v8/chain/penumbra/core/transaction/v1alpha1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeTx(t *testing.T) {
+	tx, err := proto.Marshal(&transactionv1alpha1.Transaction{BindingSig: []byte{1, 2, 3}})
+	require.NoError(t, err)
+
+	got, err := decodeTx(tx)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"bindingSig":"AQID"}`, string(got))
+
+	_, err = decodeTx([]byte("not a tx"))
+	require.Error(t, err)
+}
@@ -0,0 +1,235 @@
+package polkadot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"time"
+
+	gsrpc "github.com/misko9/go-substrate-rpc-client/v4"
+	gstypes "github.com/misko9/go-substrate-rpc-client/v4/types"
+	"github.com/misko9/go-substrate-rpc-client/v4/types/codec"
+	"github.com/strangelove-ventures/interchaintest/v8/blockdb"
+)
+
+var (
+	_ blockdb.TxFinder    = (*PolkadotChain)(nil)
+	_ blockdb.BlockFinder = (*PolkadotChain)(nil)
+)
+
+// extrinsicPayload is the human-readable representation of an extrinsic saved in blockdb.
+type extrinsicPayload struct {
+	// Call is the pallet and call name, e.g. Balances.transfer, or the call index if the metadata lacks it.
+	Call   string `json:"call"`
+	Signer string `json:"signer,omitempty"`
+	Nonce  string `json:"nonce,omitempty"`
+	Tip    string `json:"tip,omitempty"`
+	// Args are the SCALE encoded call arguments, hex encoded.
+	Args string `json:"args"`
+}
+
+// FindTxs implements blockdb.TxFinder.
+func (c *PolkadotChain) FindTxs(ctx context.Context, height int64) ([]blockdb.Tx, error) {
+	block, err := c.FindBlock(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	return block.Txs, nil
+}
+
+// FindBlock implements blockdb.BlockFinder.
+// Like Height, it finds the block of the first parachain if there is one, otherwise of the relay chain.
+// The extrinsics are saved as txs with the events they emitted,
+// and the events of the block initialization and finalization as block events.
+func (c *PolkadotChain) FindBlock(ctx context.Context, height int64) (blockdb.Block, error) {
+	api := c.blockAPI()
+
+	latest, err := api.RPC.Chain.GetHeaderLatest()
+	if err != nil {
+		return blockdb.Block{}, fmt.Errorf("get latest header: %w", err)
+	}
+	if height > int64(latest.Number) {
+		return blockdb.Block{}, fmt.Errorf("block %d: %w", height, blockdb.ErrFutureHeight)
+	}
+
+	hash, err := api.RPC.Chain.GetBlockHash(uint64(height))
+	if err != nil {
+		return blockdb.Block{}, fmt.Errorf("get block hash: %w", err)
+	}
+	signedBlock, err := api.RPC.Chain.GetBlock(hash)
+	if err != nil {
+		return blockdb.Block{}, fmt.Errorf("get block: %w", err)
+	}
+	version, err := api.RPC.State.GetRuntimeVersion(hash)
+	if err != nil {
+		return blockdb.Block{}, fmt.Errorf("get runtime version: %w", err)
+	}
+	meta, err := c.runtimeMetadata(version.SpecVersion, func() (*gstypes.Metadata, error) {
+		return api.RPC.State.GetMetadata(hash)
+	})
+	if err != nil {
+		return blockdb.Block{}, fmt.Errorf("get metadata: %w", err)
+	}
+	key, err := gstypes.CreateStorageKey(meta, "System", "Events")
+	if err != nil {
+		return blockdb.Block{}, fmt.Errorf("create events storage key: %w", err)
+	}
+	var rawEvents gstypes.EventRecordsRaw
+	if _, err := api.RPC.State.GetStorage(key, &rawEvents, hash); err != nil {
+		return blockdb.Block{}, fmt.Errorf("get events: %w", err)
+	}
+
+	block := blockdb.Block{
+		Height: height,
+		Header: blockdb.BlockHeader{
+			AppHash: signedBlock.Block.Header.StateRoot.Hex(),
+		},
+	}
+	for _, ext := range signedBlock.Block.Extrinsics {
+		payload := newExtrinsicPayload(meta, ext)
+		if payload.Call == "Timestamp.set" {
+			var ms gstypes.UCompact
+			if err := codec.Decode(ext.Method.Args, &ms); err == nil {
+				block.Header.Time = time.UnixMilli((*big.Int)(&ms).Int64())
+			}
+		}
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return blockdb.Block{}, err
+		}
+		block.Txs = append(block.Txs, blockdb.Tx{Data: data})
+	}
+
+	var records gstypes.EventRecords
+	if err := rawEvents.DecodeEventRecords(meta, &records); err != nil {
+		// Chains with custom pallets emit events unknown to the client, which cannot be decoded.
+		block.Events = []blockdb.Event{{
+			Type: "raw_events",
+			Attributes: []blockdb.EventAttribute{
+				{Key: "data", Value: fmt.Sprintf("%#x", []byte(rawEvents))},
+				{Key: "decode_error", Value: err.Error()},
+			},
+		}}
+		return block, nil
+	}
+	assignEvents(&block, &records)
+	return block, nil
+}
+
+func (c *PolkadotChain) blockAPI() *gsrpc.SubstrateAPI {
+	if len(c.ParachainNodes) > 0 && len(c.ParachainNodes[0]) > 0 {
+		return c.ParachainNodes[0][0].api
+	}
+	return c.RelayChainNodes[0].api
+}
+
+// runtimeMetadata returns the metadata of the runtime spec version, getting it with get the first time.
+// The metadata only changes with runtime upgrades, which bump the spec version.
+func (c *PolkadotChain) runtimeMetadata(specVersion gstypes.U32, get func() (*gstypes.Metadata, error)) (*gstypes.Metadata, error) {
+	c.metadataMu.Lock()
+	defer c.metadataMu.Unlock()
+	if meta, ok := c.metadata[specVersion]; ok {
+		return meta, nil
+	}
+	meta, err := get()
+	if err != nil {
+		return nil, err
+	}
+	if c.metadata == nil {
+		c.metadata = make(map[gstypes.U32]*gstypes.Metadata)
+	}
+	c.metadata[specVersion] = meta
+	return meta, nil
+}
+
+func newExtrinsicPayload(meta *gstypes.Metadata, ext gstypes.Extrinsic) extrinsicPayload {
+	payload := extrinsicPayload{
+		Call: callName(meta, ext.Method.CallIndex),
+		Args: fmt.Sprintf("%#x", []byte(ext.Method.Args)),
+	}
+	if ext.IsSigned() {
+		if ext.Signature.Signer.IsID {
+			payload.Signer = ext.Signature.Signer.AsID.ToHexString()
+		}
+		payload.Nonce = (*big.Int)(&ext.Signature.Nonce).String()
+		payload.Tip = (*big.Int)(&ext.Signature.Tip).String()
+	}
+	return payload
+}
+
+// callName finds the pallet and call name of the call index in the metadata.
+func callName(meta *gstypes.Metadata, idx gstypes.CallIndex) string {
+	if meta.Version == 14 {
+		m := meta.AsMetadataV14
+		for _, pallet := range m.Pallets {
+			if !pallet.HasCalls || uint8(pallet.Index) != idx.SectionIndex {
+				continue
+			}
+			if typ, ok := m.EfficientLookup[pallet.Calls.Type.Int64()]; ok {
+				for _, v := range typ.Def.Variant.Variants {
+					if uint8(v.Index) == idx.MethodIndex {
+						return fmt.Sprintf("%s.%s", pallet.Name, v.Name)
+					}
+				}
+			}
+		}
+	}
+	return fmt.Sprintf("%d.%d", idx.SectionIndex, idx.MethodIndex)
+}
+
+// assignEvents adds the decoded events to the extrinsics which emitted them, or to the block.
+// A failed extrinsic is given code 1, with its dispatch error as log.
+//
+// The records group events by type, so the order in which the events were emitted is lost.
+func assignEvents(block *blockdb.Block, records *gstypes.EventRecords) {
+	v := reflect.ValueOf(records).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		if field.Kind() != reflect.Slice {
+			continue
+		}
+		eventType := v.Type().Field(i).Name
+		for j := 0; j < field.Len(); j++ {
+			phase, event := polkadotEvent(eventType, field.Index(j))
+
+			if !phase.IsApplyExtrinsic || int(phase.AsApplyExtrinsic) >= len(block.Txs) {
+				block.Events = append(block.Events, event)
+				continue
+			}
+			tx := &block.Txs[phase.AsApplyExtrinsic]
+			tx.Events = append(tx.Events, event)
+			if eventType == "System_ExtrinsicFailed" {
+				tx.Code = 1
+				for _, attr := range event.Attributes {
+					if attr.Key == "DispatchError" {
+						tx.Log = attr.Value
+					}
+				}
+			}
+		}
+	}
+}
+
+// polkadotEvent converts an event struct of gstypes.EventRecords,
+// with its fields besides the phase and topics as JSON encoded attributes.
+func polkadotEvent(eventType string, ev reflect.Value) (gstypes.Phase, blockdb.Event) {
+	var phase gstypes.Phase
+	event := blockdb.Event{Type: eventType}
+	for k := 0; k < ev.NumField(); k++ {
+		name := ev.Type().Field(k).Name
+		switch name {
+		case "Phase":
+			phase, _ = ev.Field(k).Interface().(gstypes.Phase)
+		case "Topics":
+		default:
+			value, err := json.Marshal(ev.Field(k).Interface())
+			if err != nil {
+				value = []byte(fmt.Sprintf("%v", ev.Field(k).Interface()))
+			}
+			event.Attributes = append(event.Attributes, blockdb.EventAttribute{Key: name, Value: string(value)})
+		}
+	}
+	return phase, event
+}
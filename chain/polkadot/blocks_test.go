@@ -0,0 +1,80 @@
+package polkadot
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	gstypes "github.com/misko9/go-substrate-rpc-client/v4/types"
+	"github.com/strangelove-ventures/interchaintest/v8/blockdb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssignEvents(t *testing.T) {
+	applyExtrinsic := func(i uint32) gstypes.Phase {
+		return gstypes.Phase{IsApplyExtrinsic: true, AsApplyExtrinsic: i}
+	}
+
+	var records gstypes.EventRecords
+	records.System_ExtrinsicSuccess = []gstypes.EventSystemExtrinsicSuccess{
+		{Phase: applyExtrinsic(0)},
+	}
+	records.System_ExtrinsicFailed = []gstypes.EventSystemExtrinsicFailed{
+		{Phase: applyExtrinsic(1), DispatchError: gstypes.DispatchError{IsBadOrigin: true}},
+	}
+	records.Balances_Transfer = []gstypes.EventBalancesTransfer{
+		{Phase: applyExtrinsic(0), Value: gstypes.NewU128(*big.NewInt(100))},
+		{Phase: gstypes.Phase{IsFinalization: true}},
+	}
+
+	block := blockdb.Block{Txs: make([]blockdb.Tx, 2)}
+	assignEvents(&block, &records)
+
+	require.Len(t, block.Txs[0].Events, 2)
+	require.Zero(t, block.Txs[0].Code)
+
+	var transfer blockdb.Event
+	for _, e := range block.Txs[0].Events {
+		if e.Type == "Balances_Transfer" {
+			transfer = e
+		}
+	}
+	require.Len(t, transfer.Attributes, 3)
+	require.Equal(t, "Value", transfer.Attributes[2].Key)
+	require.Equal(t, "100", transfer.Attributes[2].Value)
+
+	failed := block.Txs[1]
+	require.Len(t, failed.Events, 1)
+	require.EqualValues(t, 1, failed.Code)
+	require.Contains(t, failed.Log, `"IsBadOrigin":true`)
+
+	require.Len(t, block.Events, 1)
+	require.Equal(t, "Balances_Transfer", block.Events[0].Type)
+}
+
+func TestRuntimeMetadata(t *testing.T) {
+	var c PolkadotChain
+	gets := 0
+	get := func() (*gstypes.Metadata, error) {
+		gets++
+		return &gstypes.Metadata{Version: uint8(gets)}, nil
+	}
+
+	meta, err := c.runtimeMetadata(1, get)
+	require.NoError(t, err)
+	cached, err := c.runtimeMetadata(1, get)
+	require.NoError(t, err)
+	require.Same(t, meta, cached)
+	require.Equal(t, 1, gets)
+
+	upgraded, err := c.runtimeMetadata(2, get)
+	require.NoError(t, err)
+	require.NotSame(t, meta, upgraded)
+	require.Equal(t, 2, gets)
+
+	_, err = c.runtimeMetadata(3, func() (*gstypes.Metadata, error) { return nil, errors.New("boom") })
+	require.ErrorContains(t, err, "boom")
+	_, err = c.runtimeMetadata(3, get)
+	require.NoError(t, err)
+	require.Equal(t, 3, gets)
+}
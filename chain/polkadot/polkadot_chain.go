@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"sync"
 
 	"cosmossdk.io/math"
 	"github.com/99designs/keyring"
@@ -22,7 +23,6 @@ import (
 	p2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/misko9/go-substrate-rpc-client/v4/signature"
 	gstypes "github.com/misko9/go-substrate-rpc-client/v4/types"
-	"github.com/strangelove-ventures/interchaintest/v8/blockdb"
 	"github.com/strangelove-ventures/interchaintest/v8/dockerutil"
 	"github.com/strangelove-ventures/interchaintest/v8/ibc"
 	"go.uber.org/zap"
@@ -42,6 +42,10 @@ type PolkadotChain struct {
 	RelayChainNodes    RelayChainNodes
 	ParachainNodes     []ParachainNodes
 	keyring            keyring.Keyring
+
+	// Metadata used by FindBlock, by runtime spec version.
+	metadataMu sync.Mutex
+	metadata   map[gstypes.U32]*gstypes.Metadata
 }
 
 // PolkadotAuthority is used when constructing the validator authorities in the substrate chain spec.
@@ -841,11 +845,6 @@ func (c *PolkadotChain) GetKeyringPair(keyName string) (signature.KeyringPair, e
 	return kp, nil
 }
 
-// FindTxs implements blockdb.BlockSaver (Not implemented yet for polkadot, but we don't want to exit)
-func (c *PolkadotChain) FindTxs(ctx context.Context, height int64) ([]blockdb.Tx, error) {
-	return []blockdb.Tx{}, nil
-}
-
 // GetIbcBalance returns the Coins type of ibc coins in account
 func (c *PolkadotChain) GetIbcBalance(ctx context.Context, address string, denom uint64) (sdktypes.Coin, error) {
 	return c.ParachainNodes[0][0].GetIbcBalance(ctx, address, denom)
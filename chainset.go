@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"io"
 	"os"
 	"sync"
 	"time"
@@ -28,6 +29,7 @@ type chainSet struct {
 	// The following fields are set during TrackBlocks, and used in Close.
 	trackerEg  *errgroup.Group
 	db         *sql.DB
+	testCase   *blockdb.TestCase
 	collectors []*blockdb.Collector
 }
 
@@ -123,7 +125,7 @@ func (cs *chainSet) Start(ctx context.Context, testName string, additionalGenesi
 // The gitSha is used to pin a git commit to a test invocation. Thus, when a user is looking at historical
 // data they are able to determine which version of the code produced the results.
 // Expected to be called after Start.
-func (cs chainSet) TrackBlocks(ctx context.Context, testName, dbPath, gitSha string) error {
+func (cs *chainSet) TrackBlocks(ctx context.Context, testName, dbPath, gitSha string) error {
 	if len(dbPath) == 0 {
 		// nop
 		return nil
@@ -148,33 +150,32 @@ func (cs chainSet) TrackBlocks(ctx context.Context, testName, dbPath, gitSha str
 		_ = db.Close()
 		return fmt.Errorf("create test case in sqlite database: %w", err)
 	}
+	cs.testCase = testCase
 
 	// TODO (nix - 6/1/22) Need logger instead of fmt.Fprint
 	cs.trackerEg = new(errgroup.Group)
-	cs.collectors = make([]*blockdb.Collector, len(cs.chains))
-	i := 0
+	// The collectors are created before collecting, so that Close can stop all of them at any time.
 	for c := range cs.chains {
-		c := c
 		id := c.Config().ChainID
 		finder, ok := c.(blockdb.TxFinder)
 		if !ok {
-			fmt.Fprintf(os.Stderr, `Chain %s is not configured to save blocks; must implement "FindTxs(ctx context.Context, height int64) ([][]byte, error)"`+"\n", id)
-			return nil
+			fmt.Fprintf(os.Stderr, `Chain %s is not configured to save blocks; must implement "FindTxs(ctx context.Context, height int64) ([]blockdb.Tx, error)"`+"\n", id)
+			continue
+		}
+		chaindb, err := testCase.AddChain(ctx, id, c.Config().Type)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to add chain %s to database: %v", id, err)
+			continue
 		}
-		j := i // Avoid closure on loop variable.
+		log := cs.log.With(zap.String("chain_id", id))
+		cs.collectors = append(cs.collectors, blockdb.NewCollector(log, finder, chaindb, 100*time.Millisecond))
+	}
+	for _, collector := range cs.collectors {
+		collector := collector
 		cs.trackerEg.Go(func() error {
-			chaindb, err := testCase.AddChain(ctx, id, c.Config().Type)
-			if err != nil {
-				fmt.Fprintf(os.Stderr, "Failed to add chain %s to database: %v", id, err)
-				return nil
-			}
-			log := cs.log.With(zap.String("chain_id", id))
-			collector := blockdb.NewCollector(log, finder, chaindb, 100*time.Millisecond)
-			cs.collectors[j] = collector
 			collector.Collect(ctx)
 			return nil
 		})
-		i++
 	}
 
 	return nil
@@ -182,18 +183,22 @@ func (cs chainSet) TrackBlocks(ctx context.Context, testName, dbPath, gitSha str
 
 // Close frees any resources associated with the chainSet.
 //
-// Currently, it only frees resources from TrackBlocks.
+// Currently, it only frees resources from TrackBlocks,
+// including the clients chains implementing io.Closer keep for finding blocks.
 // Close is safe to call even if TrackBlocks was not called.
 func (cs *chainSet) Close() error {
 	for _, c := range cs.collectors {
-		if c != nil {
-			c.Stop()
-		}
+		c.Stop()
 	}
 
 	var err error
 	if cs.trackerEg != nil {
 		multierr.AppendInto(&err, cs.trackerEg.Wait())
+		for c := range cs.chains {
+			if closer, ok := c.(io.Closer); ok {
+				multierr.AppendInto(&err, closer.Close())
+			}
+		}
 	}
 	if cs.db != nil {
 		multierr.AppendInto(&err, cs.db.Close())
@@ -0,0 +1,55 @@
+package interchaintest
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/strangelove-ventures/interchaintest/v8/blockdb"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// blockFinderChain is an ibc.Chain which only implements what TrackBlocks uses.
+type blockFinderChain struct {
+	ibc.Chain
+	chainID string
+	closed  bool
+}
+
+func (c *blockFinderChain) Config() ibc.ChainConfig {
+	return ibc.ChainConfig{ChainID: c.chainID, Type: "cosmos"}
+}
+
+func (c *blockFinderChain) FindTxs(ctx context.Context, height int64) ([]blockdb.Tx, error) {
+	return nil, blockdb.ErrFutureHeight
+}
+
+func (c *blockFinderChain) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestChainSet_TrackBlocks_Close(t *testing.T) {
+	a, b := &blockFinderChain{chainID: "a"}, &blockFinderChain{chainID: "b"}
+	cs := newChainSet(zap.NewNop(), []ibc.Chain{a, b})
+
+	// The build context outlives Close, so Close must stop the collectors itself.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, cs.TrackBlocks(ctx, t.Name(), filepath.Join(t.TempDir(), "blocks.db"), "sha"))
+	require.Len(t, cs.collectors, 2)
+
+	closed := make(chan error)
+	go func() { closed <- cs.Close() }()
+	select {
+	case err := <-closed:
+		require.NoError(t, err)
+		require.True(t, a.closed)
+		require.True(t, b.closed)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Close did not stop the collectors")
+	}
+}
@@ -0,0 +1,137 @@
+package interchaintest
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/strangelove-ventures/interchaintest/v8/blockdb"
+)
+
+// ClientUpdateStats summarizes the light client updates which a signer made to a client,
+// so that the client update frequency and costs of relayer implementations can be compared.
+type ClientUpdateStats struct {
+	// Name the relayer was added to the Interchain with,
+	// or empty if Signer is not the wallet of a relayer, e.g. a test user.
+	RelayerName string
+	Signer      string
+
+	// ChainID of the chain hosting the client.
+	ChainID  string
+	ClientID string
+
+	Updates int
+
+	// Total and largest size in bytes of the headers of the updates.
+	HeaderBytes, MaxHeaderBytes int
+
+	// Gas used and wanted by the txs which carried the updates, counting each tx once.
+	// Relayers batch updates with packet messages, so this includes the gas of those messages.
+	GasUsed, GasWanted int64
+
+	FirstUpdate, LastUpdate time.Time
+
+	// SinceLastUpdate is the time elapsed between LastUpdate and the query.
+	SinceLastUpdate time.Duration
+}
+
+// AvgHeaderBytes is the average size in bytes of the headers of the updates.
+func (s ClientUpdateStats) AvgHeaderBytes() int {
+	if s.Updates == 0 {
+		return 0
+	}
+	return s.HeaderBytes / s.Updates
+}
+
+// UpdateInterval is the average time between two updates, or 0 if there was a single update.
+func (s ClientUpdateStats) UpdateInterval() time.Duration {
+	if s.Updates < 2 {
+		return 0
+	}
+	return s.LastUpdate.Sub(s.FirstUpdate) / time.Duration(s.Updates-1)
+}
+
+// ClientUpdateStats reports the light client updates saved in the block database so far,
+// per client and signer, with the signers matched to the relayer wallets.
+// The results are sorted by relayer name, chain ID, client ID and signer.
+//
+// The block database must have been enabled through InterchainBuildOptions.BlockDatabaseFile.
+// Update times are those at which the blocks were saved, so they trail the block times slightly.
+func (ic *Interchain) ClientUpdateStats(ctx context.Context) ([]ClientUpdateStats, error) {
+	if ic.cs.testCase == nil {
+		return nil, errors.New("ClientUpdateStats requires the BlockDatabaseFile build option")
+	}
+
+	updates, err := blockdb.NewQuery(ic.cs.db).ClientUpdates(ctx, ic.cs.testCase.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	relayerNames := make(map[string]string)
+	for _, w := range ic.RelayerWallets() {
+		relayerNames[w.Wallet.FormattedAddress()] = w.RelayerName
+	}
+	return summarizeClientUpdates(updates, relayerNames, time.Now()), nil
+}
+
+// summarizeClientUpdates aggregates updates per client and signer.
+// The relayerNames are keyed by the address of the relayer wallets.
+func summarizeClientUpdates(updates []blockdb.ClientUpdateResult, relayerNames map[string]string, now time.Time) []ClientUpdateStats {
+	type statsKey struct {
+		chainID, clientID, signer string
+	}
+	byKey := make(map[statsKey]*ClientUpdateStats)
+	countedTxs := make(map[statsKey]map[int64]bool)
+
+	for _, u := range updates {
+		k := statsKey{chainID: u.ChainID, clientID: u.ClientID, signer: u.Signer.String}
+		s, ok := byKey[k]
+		if !ok {
+			s = &ClientUpdateStats{
+				RelayerName: relayerNames[u.Signer.String],
+				Signer:      u.Signer.String,
+				ChainID:     u.ChainID,
+				ClientID:    u.ClientID,
+				FirstUpdate: u.CreatedAt,
+			}
+			byKey[k] = s
+			countedTxs[k] = make(map[int64]bool)
+		}
+
+		s.Updates++
+		s.HeaderBytes += u.HeaderSize
+		s.MaxHeaderBytes = max(s.MaxHeaderBytes, u.HeaderSize)
+		if !countedTxs[k][u.TxID] {
+			countedTxs[k][u.TxID] = true
+			s.GasUsed += u.GasUsed
+			s.GasWanted += u.GasWanted
+		}
+		if u.CreatedAt.Before(s.FirstUpdate) {
+			s.FirstUpdate = u.CreatedAt
+		}
+		if u.CreatedAt.After(s.LastUpdate) {
+			s.LastUpdate = u.CreatedAt
+		}
+	}
+
+	stats := make([]ClientUpdateStats, 0, len(byKey))
+	for _, s := range byKey {
+		s.SinceLastUpdate = now.Sub(s.LastUpdate)
+		stats = append(stats, *s)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		a, b := stats[i], stats[j]
+		if a.RelayerName != b.RelayerName {
+			return a.RelayerName < b.RelayerName
+		}
+		if a.ChainID != b.ChainID {
+			return a.ChainID < b.ChainID
+		}
+		if a.ClientID != b.ClientID {
+			return a.ClientID < b.ClientID
+		}
+		return a.Signer < b.Signer
+	})
+	return stats
+}
@@ -0,0 +1,68 @@
+package interchaintest
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/strangelove-ventures/interchaintest/v8/blockdb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummarizeClientUpdates(t *testing.T) {
+	start := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	update := func(chainID, clientID, signer string, txID int64, at time.Duration, headerSize int) blockdb.ClientUpdateResult {
+		return blockdb.ClientUpdateResult{
+			ChainID:    chainID,
+			ClientID:   clientID,
+			Signer:     sql.NullString{String: signer, Valid: true},
+			TxID:       txID,
+			CreatedAt:  start.Add(at),
+			HeaderSize: headerSize,
+			GasUsed:    100,
+			GasWanted:  150,
+		}
+	}
+
+	updates := []blockdb.ClientUpdateResult{
+		update("chain-a", "07-tendermint-0", "cosmos1hermes", 1, 0, 1000),
+		// Two clients updated by the same tx.
+		update("chain-a", "07-tendermint-0", "cosmos1rly", 2, time.Second, 800),
+		update("chain-a", "07-tendermint-1", "cosmos1rly", 2, time.Second, 900),
+		update("chain-a", "07-tendermint-0", "cosmos1hermes", 3, 10*time.Second, 1400),
+		update("chain-a", "07-tendermint-0", "cosmos1hermes", 4, 20*time.Second, 1200),
+		update("chain-b", "07-tendermint-0", "cosmos1user", 5, 30*time.Second, 700),
+	}
+	relayerNames := map[string]string{
+		"cosmos1hermes": "hermes",
+		"cosmos1rly":    "rly",
+	}
+
+	stats := summarizeClientUpdates(updates, relayerNames, start.Add(time.Minute))
+	require.Len(t, stats, 4)
+
+	// Signers which are not relayers sort first.
+	require.Empty(t, stats[0].RelayerName)
+	require.Equal(t, "cosmos1user", stats[0].Signer)
+
+	got := stats[1]
+	require.Equal(t, "hermes", got.RelayerName)
+	require.Equal(t, "chain-a", got.ChainID)
+	require.Equal(t, "07-tendermint-0", got.ClientID)
+	require.Equal(t, 3, got.Updates)
+	require.Equal(t, 3600, got.HeaderBytes)
+	require.Equal(t, 1400, got.MaxHeaderBytes)
+	require.Equal(t, 1200, got.AvgHeaderBytes())
+	require.EqualValues(t, 300, got.GasUsed)
+	require.EqualValues(t, 450, got.GasWanted)
+	require.Equal(t, start, got.FirstUpdate)
+	require.Equal(t, start.Add(20*time.Second), got.LastUpdate)
+	require.Equal(t, 40*time.Second, got.SinceLastUpdate)
+	require.Equal(t, 10*time.Second, got.UpdateInterval())
+
+	require.Equal(t, "rly", stats[2].RelayerName)
+	require.Equal(t, "07-tendermint-0", stats[2].ClientID)
+	require.Equal(t, "07-tendermint-1", stats[3].ClientID)
+	require.EqualValues(t, 100, stats[3].GasUsed)
+	require.Zero(t, stats[3].UpdateInterval())
+}
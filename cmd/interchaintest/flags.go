@@ -19,6 +19,24 @@ type mainFlags struct {
 	MatrixFile        string
 	ReportFile        string
 	BlockDatabaseFile string
+	// How often the debug UI refreshes from the database, to follow running tests.
+	BlockDatabaseRefresh time.Duration
+
+	PruneOlderThanDays    int
+	PruneKeepRuns         int
+	CompressOlderThanDays int
+	Vacuum                bool
+
+	IngestRPC     string
+	IngestChainID string
+	IngestName    string
+	IngestFrom    int64
+	IngestTo      int64
+	IngestFollow  bool
+
+	MatrixReportFile string
+	MatrixFormat     string
+	MatrixOut        string
 }
 
 func (f mainFlags) Logger() (lc LoggerCloser, _ error) {
@@ -0,0 +1,75 @@
+package interchaintest
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	interchaintest "github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/blockdb"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"go.uber.org/zap"
+)
+
+// ingestResult describes what ingestBlocks saved.
+type ingestResult struct {
+	TestCaseID int64
+	ChainID    string
+	From, To   int64
+}
+
+// ingestBlocks saves the blocks of the cosmos chain with the CometBFT RPC address f.IngestRPC into db, as a new test case.
+// It saves the heights from f.IngestFrom to f.IngestTo, defaulting to the earliest and the latest height of the node.
+// If f.IngestFollow is set and f.IngestTo is not, it keeps saving new blocks until ctx is done.
+//
+// Txs are decoded with cosmos.DefaultEncoding, which registers the SDK and ibc-go modules. Txs with messages of other
+// modules are saved with their raw bytes and a decode error; to decode them, collect the blocks from Go with
+// cosmos.NewRPCBlockFinder and the interface registry of the chain.
+func ingestBlocks(ctx context.Context, log *zap.Logger, db *sql.DB, f mainFlags) (ingestResult, error) {
+	finder, err := cosmos.NewRPCBlockFinder(log, f.IngestRPC, cosmos.DefaultEncoding().InterfaceRegistry)
+	if err != nil {
+		return ingestResult{}, fmt.Errorf("rpc client %s: %w", f.IngestRPC, err)
+	}
+	status, err := finder.Status(ctx)
+	if err != nil {
+		return ingestResult{}, err
+	}
+
+	res := ingestResult{ChainID: f.IngestChainID, From: f.IngestFrom, To: f.IngestTo}
+	if res.ChainID == "" {
+		res.ChainID = status.NodeInfo.Network
+	}
+	earliest := max(status.SyncInfo.EarliestBlockHeight, 1)
+	if res.From == 0 {
+		res.From = earliest
+	}
+	if res.From < earliest {
+		return ingestResult{}, fmt.Errorf("height %d was pruned, the earliest height of the node is %d", res.From, earliest)
+	}
+	if res.To == 0 && !f.IngestFollow {
+		res.To = status.SyncInfo.LatestBlockHeight
+	}
+	if res.To != 0 && res.To < res.From {
+		return ingestResult{}, fmt.Errorf("last height %d is below first height %d", res.To, res.From)
+	}
+
+	name := f.IngestName
+	if name == "" {
+		name = "ingest " + res.ChainID
+	}
+	testCase, err := blockdb.CreateTestCase(ctx, db, name, interchaintest.GitSha)
+	if err != nil {
+		return ingestResult{}, fmt.Errorf("create test case: %w", err)
+	}
+	res.TestCaseID = testCase.ID()
+	chain, err := testCase.AddChain(ctx, res.ChainID, "cosmos")
+	if err != nil {
+		return res, fmt.Errorf("add chain %s: %w", res.ChainID, err)
+	}
+
+	if err := blockdb.NewCollector(log, finder, chain, 100*time.Millisecond).CollectRange(ctx, res.From, res.To); err != nil {
+		return res, fmt.Errorf("collect blocks: %w", err)
+	}
+	return res, nil
+}
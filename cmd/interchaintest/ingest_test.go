@@ -0,0 +1,192 @@
+package interchaintest
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/p2p"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/strangelove-ventures/interchaintest/v8/blockdb"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+)
+
+// newStandInRPC starts a CometBFT JSON-RPC server for a chain at height latest, with one bank send per block.
+func newStandInRPC(t *testing.T, chainID string, earliest, latest int64) *httptest.Server {
+	t.Helper()
+
+	encoding := cosmos.DefaultEncoding()
+	builder := encoding.TxConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(&banktypes.MsgSend{
+		FromAddress: "cosmos1from",
+		ToAddress:   "cosmos1to",
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)),
+	}))
+	tx, err := encoding.TxConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req rpctypes.RPCRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var params struct {
+			Height string `json:"height"`
+		}
+		_ = json.Unmarshal(req.Params, &params)
+		height, _ := strconv.ParseInt(params.Height, 10, 64)
+
+		var res any
+		switch {
+		case req.Method == "status":
+			res = &coretypes.ResultStatus{
+				NodeInfo: p2p.DefaultNodeInfo{Network: chainID},
+				SyncInfo: coretypes.SyncInfo{EarliestBlockHeight: earliest, LatestBlockHeight: latest},
+			}
+		case height > latest:
+			_ = json.NewEncoder(w).Encode(rpctypes.RPCInternalError(req.ID,
+				errors.New("height must be less than or equal to the current blockchain height")))
+			return
+		case req.Method == "block":
+			res = &coretypes.ResultBlock{Block: &cmttypes.Block{
+				Header: cmttypes.Header{ChainID: chainID, Height: height, Time: time.Unix(height, 0).UTC()},
+				Data:   cmttypes.Data{Txs: cmttypes.Txs{tx}},
+			}}
+		case req.Method == "block_results":
+			res = &coretypes.ResultBlockResults{
+				Height:              height,
+				TxsResults:          []*abcitypes.ExecTxResult{{GasUsed: 10, GasWanted: 20}},
+				FinalizeBlockEvents: []abcitypes.Event{{Type: "mint"}},
+			}
+		default:
+			_ = json.NewEncoder(w).Encode(rpctypes.RPCMethodNotFoundError(req.ID))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(rpctypes.NewRPCSuccessResponse(req.ID, res))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestIngestBlocks(t *testing.T) {
+	ctx := context.Background()
+
+	newDB := func(t *testing.T) *sql.DB {
+		t.Helper()
+		db, err := blockdb.ConnectDB(ctx, filepath.Join(t.TempDir(), "blocks.db"))
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = db.Close() })
+		require.NoError(t, blockdb.Migrate(db, "test"))
+		return db
+	}
+
+	t.Run("default range", func(t *testing.T) {
+		srv := newStandInRPC(t, "devnet-1", 2, 4)
+		db := newDB(t)
+
+		res, err := ingestBlocks(ctx, zap.NewNop(), db, mainFlags{IngestRPC: srv.URL})
+		require.NoError(t, err)
+		require.Equal(t, ingestResult{TestCaseID: res.TestCaseID, ChainID: "devnet-1", From: 2, To: 4}, res)
+
+		q := blockdb.NewQuery(db)
+		testCases, err := q.RecentTestCases(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, testCases, 1)
+		require.Equal(t, res.TestCaseID, testCases[0].ID)
+		require.Equal(t, "ingest devnet-1", testCases[0].Name)
+		require.Equal(t, "devnet-1", testCases[0].ChainID)
+		require.Equal(t, "cosmos", testCases[0].ChainType)
+		require.EqualValues(t, 4, testCases[0].ChainHeight.Int64)
+
+		blocks, err := q.Blocks(ctx, testCases[0].ChainPKey)
+		require.NoError(t, err)
+		require.Len(t, blocks, 3)
+		require.EqualValues(t, 2, blocks[0].Height)
+
+		txs, err := q.Transactions(ctx, testCases[0].ChainPKey)
+		require.NoError(t, err)
+		require.Len(t, txs, 3)
+		require.Empty(t, txs[0].DecodeError)
+		require.Contains(t, string(txs[0].Tx), "/cosmos.bank.v1beta1.MsgSend")
+		require.NotEmpty(t, txs[0].Raw)
+		require.EqualValues(t, 10, txs[0].GasUsed)
+	})
+
+	t.Run("explicit range", func(t *testing.T) {
+		srv := newStandInRPC(t, "devnet-1", 1, 10)
+		db := newDB(t)
+
+		res, err := ingestBlocks(ctx, zap.NewNop(), db, mainFlags{
+			IngestRPC:     srv.URL,
+			IngestChainID: "renamed-1",
+			IngestName:    "my devnet",
+			IngestFrom:    3,
+			IngestTo:      5,
+		})
+		require.NoError(t, err)
+
+		q := blockdb.NewQuery(db)
+		testCases, err := q.RecentTestCases(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, testCases, 1)
+		require.Equal(t, res.TestCaseID, testCases[0].ID)
+		require.Equal(t, "my devnet", testCases[0].Name)
+		require.Equal(t, "renamed-1", testCases[0].ChainID)
+
+		blocks, err := q.Blocks(ctx, testCases[0].ChainPKey)
+		require.NoError(t, err)
+		require.Len(t, blocks, 3)
+		require.EqualValues(t, 3, blocks[0].Height)
+		require.EqualValues(t, 5, blocks[2].Height)
+	})
+
+	t.Run("pruned height", func(t *testing.T) {
+		srv := newStandInRPC(t, "devnet-1", 5, 10)
+
+		_, err := ingestBlocks(ctx, zap.NewNop(), newDB(t), mainFlags{IngestRPC: srv.URL, IngestFrom: 2})
+		require.ErrorContains(t, err, "earliest height of the node is 5")
+	})
+
+	t.Run("failing height", func(t *testing.T) {
+		standIn := newStandInRPC(t, "devnet-1", 1, 4)
+		// Forwards the status, but fails to serve blocks.
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			var req rpctypes.RPCRequest
+			if err := json.Unmarshal(body, &req); err != nil {
+				// E.g. the websocket subscription to new blocks.
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if req.Method != "status" {
+				_ = json.NewEncoder(w).Encode(rpctypes.RPCInternalError(req.ID, errors.New("boom")))
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			standIn.Config.Handler.ServeHTTP(w, r)
+		}))
+		t.Cleanup(srv.Close)
+
+		_, err := ingestBlocks(ctx, zap.NewNop(), newDB(t), mainFlags{IngestRPC: srv.URL})
+		require.ErrorContains(t, err, "height 1 failed")
+		require.ErrorContains(t, err, "boom")
+	})
+}
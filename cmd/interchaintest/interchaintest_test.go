@@ -7,6 +7,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"testing"
 	"time"
@@ -34,6 +35,19 @@ func init() {
 `)
 		debugFlagSet.PrintDefaults()
 		fmt.Fprint(out, `
+  ingest  Save the blocks of any cosmos chain from its CometBFT RPC into the block database, as a new test case.
+          Txs are decoded with the modules of the SDK and ibc-go; messages of other modules are saved undecoded.
+`)
+		ingestFlagSet.PrintDefaults()
+		fmt.Fprint(out, `
+  matrix  Write the relayer compatibility matrix of a test report.
+`)
+		matrixFlagSet.PrintDefaults()
+		fmt.Fprint(out, `
+  prune  Delete old test cases from the block database, compress tx data and vacuum.
+`)
+		pruneFlagSet.PrintDefaults()
+		fmt.Fprint(out, `
   version  Prints git commit that produced executable.
 `)
 	}
@@ -46,7 +60,12 @@ var testMatrix struct {
 	ChainSets [][]*interchaintest.ChainSpec
 }
 
-var debugFlagSet = flag.NewFlagSet("debug", flag.ExitOnError)
+var (
+	debugFlagSet  = flag.NewFlagSet("debug", flag.ExitOnError)
+	ingestFlagSet = flag.NewFlagSet("ingest", flag.ExitOnError)
+	matrixFlagSet = flag.NewFlagSet("matrix", flag.ExitOnError)
+	pruneFlagSet  = flag.NewFlagSet("prune", flag.ExitOnError)
+)
 
 func TestMain(m *testing.M) {
 	addFlags()
@@ -61,6 +80,24 @@ func TestMain(m *testing.M) {
 			os.Exit(1)
 		}
 		os.Exit(0)
+	case "ingest":
+		if err := ingestBlockDatabase(ctx); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to ingest blocks: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	case "matrix":
+		if err := writeCompatibilityMatrix(extraFlags.MatrixReportFile, extraFlags.MatrixFormat, extraFlags.MatrixOut); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to write compatibility matrix: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	case "prune":
+		if err := pruneBlockDatabase(ctx); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to prune block database: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
 	case "version":
 		fmt.Fprintln(os.Stderr, interchaintest.GitSha)
 		os.Exit(0)
@@ -149,7 +186,7 @@ func configureTestReporter() error {
 	if err != nil {
 		return fmt.Errorf("failed to get user home dir: %w", err)
 	}
-	fpath := filepath.Join(home, ".interchaintest", "reports")
+	fpath := filepath.Join(home, reportsDir)
 	err = os.MkdirAll(fpath, 0755)
 	if err != nil {
 		return fmt.Errorf("mkdirall: %w", err)
@@ -246,6 +283,25 @@ func addFlags() {
 	flag.StringVar(&extraFlags.ReportFile, "report-file", "", "Path where test report will be stored. Defaults to $HOME/.interchaintest/reports/$TIMESTAMP.json")
 
 	debugFlagSet.StringVar(&extraFlags.BlockDatabaseFile, "block-db", interchaintest.DefaultBlockDatabaseFilepath(), "Path to database sqlite file that tracks blocks and transactions.")
+	debugFlagSet.DurationVar(&extraFlags.BlockDatabaseRefresh, "refresh", time.Second, "How often to refresh from the database, to follow running tests. Zero disables refreshing.")
+
+	ingestFlagSet.StringVar(&extraFlags.BlockDatabaseFile, "block-db", interchaintest.DefaultBlockDatabaseFilepath(), "Path to database sqlite file that tracks blocks and transactions.")
+	ingestFlagSet.StringVar(&extraFlags.IngestRPC, "rpc", "http://localhost:26657", "CometBFT RPC address of a node of the chain.")
+	ingestFlagSet.StringVar(&extraFlags.IngestChainID, "chain-id", "", "Chain ID to save the blocks under. Defaults to the chain ID of the node.")
+	ingestFlagSet.StringVar(&extraFlags.IngestName, "name", "", "Name of the test case to create. Defaults to ingest followed by the chain ID.")
+	ingestFlagSet.Int64Var(&extraFlags.IngestFrom, "from", 0, "First height to save. Defaults to the earliest height of the node.")
+	ingestFlagSet.Int64Var(&extraFlags.IngestTo, "to", 0, "Last height to save. Defaults to the latest height of the node.")
+	ingestFlagSet.BoolVar(&extraFlags.IngestFollow, "follow", false, "Keep saving new blocks until interrupted, instead of stopping at the latest height. Ignored if -to is set.")
+
+	matrixFlagSet.StringVar(&extraFlags.MatrixReportFile, "report", "", "Path to the test report to read. Defaults to the latest report in $HOME/.interchaintest/reports")
+	matrixFlagSet.StringVar(&extraFlags.MatrixFormat, "format", "markdown", "Output format: markdown|html")
+	matrixFlagSet.StringVar(&extraFlags.MatrixOut, "out", "", "Path to write the matrix to. Defaults to stdout")
+
+	pruneFlagSet.StringVar(&extraFlags.BlockDatabaseFile, "block-db", interchaintest.DefaultBlockDatabaseFilepath(), "Path to database sqlite file that tracks blocks and transactions.")
+	pruneFlagSet.IntVar(&extraFlags.PruneOlderThanDays, "older-than-days", 0, "Delete test cases created more than this many days ago. Zero keeps all.")
+	pruneFlagSet.IntVar(&extraFlags.PruneKeepRuns, "keep-runs", 0, "Delete all but the latest runs of each test name. Zero keeps all.")
+	pruneFlagSet.IntVar(&extraFlags.CompressOlderThanDays, "compress-older-than-days", -1, "Compress the tx data of test cases created more than this many days ago. Negative disables compression.")
+	pruneFlagSet.BoolVar(&extraFlags.Vacuum, "vacuum", true, "Vacuum the database to shrink the file.")
 }
 
 func parseFlags() {
@@ -254,6 +310,12 @@ func parseFlags() {
 	case "debug":
 		// Ignore errors because configured with flag.ExitOnError.
 		_ = debugFlagSet.Parse(os.Args[2:])
+	case "ingest":
+		_ = ingestFlagSet.Parse(os.Args[2:])
+	case "matrix":
+		_ = matrixFlagSet.Parse(os.Args[2:])
+	case "prune":
+		_ = pruneFlagSet.Parse(os.Args[2:])
 	}
 }
 
@@ -286,7 +348,7 @@ func runDebugTerminalUI(ctx context.Context) error {
 		return fmt.Errorf("query schema version: %w", err)
 	}
 
-	testCases, err := querySvc.RecentTestCases(ctx, 100)
+	testCases, err := querySvc.RecentTestCases(ctx, blockdbtui.RecentTestCasesLimit)
 	if err != nil {
 		return fmt.Errorf("query recent test cases: %w", err)
 	}
@@ -296,8 +358,103 @@ func runDebugTerminalUI(ctx context.Context) error {
 
 	app := tview.NewApplication()
 	model := blockdbtui.NewModel(blockdb.NewQuery(db), dbPath, schemaInfo.GitSha, schemaInfo.CreatedAt, testCases)
+	if refresh := extraFlags.BlockDatabaseRefresh; refresh > 0 {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go func() {
+			ticker := time.NewTicker(refresh)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					// Query here, so that only showing the results blocks the UI.
+					app.QueueUpdateDraw(model.Refresh(ctx))
+				}
+			}
+		}()
+	}
 	return app.
 		SetInputCapture(model.Update(ctx)).
 		SetRoot(model.RootView(), true).
 		Run()
 }
+
+func ingestBlockDatabase(ctx context.Context) error {
+	dbPath := extraFlags.BlockDatabaseFile
+	db, err := blockdb.ConnectDB(ctx, dbPath)
+	if err != nil {
+		return fmt.Errorf("connect to database %s: %w", dbPath, err)
+	}
+	defer db.Close()
+
+	if err = blockdb.Migrate(db, interchaintest.GitSha); err != nil {
+		return fmt.Errorf("migrate database %s: %w", dbPath, err)
+	}
+
+	lc, err := extraFlags.Logger()
+	if err != nil {
+		return err
+	}
+	defer lc.Close()
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	res, err := ingestBlocks(ctx, lc.Logger, db, extraFlags)
+	if err != nil {
+		return err
+	}
+	if res.To == 0 {
+		fmt.Fprintf(os.Stderr, "Saved chain %s from height %d into test case %d of %s\n", res.ChainID, res.From, res.TestCaseID, dbPath)
+	} else {
+		fmt.Fprintf(os.Stderr, "Saved chain %s heights %d to %d into test case %d of %s\n", res.ChainID, res.From, res.To, res.TestCaseID, dbPath)
+	}
+	return nil
+}
+
+func pruneBlockDatabase(ctx context.Context) error {
+	dbPath := extraFlags.BlockDatabaseFile
+
+	// Explicitly check for file existence otherwise blockdb.ConnectDB implicitly creates and migrates a sqlite file.
+	if _, err := os.Stat(dbPath); err != nil {
+		return err
+	}
+
+	db, err := blockdb.ConnectDB(ctx, dbPath)
+	if err != nil {
+		return fmt.Errorf("connect to database %s: %w", dbPath, err)
+	}
+	defer db.Close()
+
+	if err = blockdb.Migrate(db, interchaintest.GitSha); err != nil {
+		return fmt.Errorf("migrate database %s: %w", dbPath, err)
+	}
+
+	const day = 24 * time.Hour
+	deleted, err := blockdb.PruneTestCases(ctx, db, blockdb.PruneOptions{
+		OlderThan: time.Duration(extraFlags.PruneOlderThanDays) * day,
+		KeepRuns:  extraFlags.PruneKeepRuns,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Deleted %d test cases\n", deleted)
+
+	if extraFlags.CompressOlderThanDays >= 0 {
+		compressed, err := blockdb.CompressTxData(ctx, db, time.Duration(extraFlags.CompressOlderThanDays)*day)
+		if err != nil {
+			return fmt.Errorf("compress tx data: %w", err)
+		}
+		fmt.Fprintf(os.Stderr, "Compressed %d txs\n", compressed)
+	}
+
+	if extraFlags.Vacuum {
+		if err := blockdb.Vacuum(ctx, db); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "Vacuumed %s\n", dbPath)
+	}
+	return nil
+}
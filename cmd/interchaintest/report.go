@@ -0,0 +1,90 @@
+package interchaintest
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+)
+
+// Directory under the user home directory where test reports are written.
+var reportsDir = filepath.Join(".interchaintest", "reports")
+
+// writeCompatibilityMatrix writes the compatibility matrix of the report at reportPath to outPath, in the given format.
+// It reads the latest report if reportPath is empty, and writes to stdout if outPath is empty.
+func writeCompatibilityMatrix(reportPath, format, outPath string) error {
+	if reportPath == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return fmt.Errorf("failed to get user home dir: %w", err)
+		}
+		reportPath, err = latestReport(filepath.Join(home, reportsDir))
+		if err != nil {
+			return err
+		}
+	}
+
+	var write func(*testreporter.CompatibilityMatrix, io.Writer) error
+	switch format {
+	case "markdown", "":
+		write = (*testreporter.CompatibilityMatrix).WriteMarkdown
+	case "html":
+		write = (*testreporter.CompatibilityMatrix).WriteHTML
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+
+	f, err := os.Open(reportPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	msgs, err := testreporter.ReadMessages(f)
+	if err != nil {
+		return fmt.Errorf("read report %s: %w", reportPath, err)
+	}
+	matrix := testreporter.NewCompatibilityMatrix(msgs)
+
+	if outPath == "" {
+		return write(matrix, os.Stdout)
+	}
+
+	out, err := os.Create(outPath)
+	if err != nil {
+		return err
+	}
+	if err := write(matrix, out); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// latestReport returns the path of the most recently modified report in dir.
+func latestReport(dir string) (string, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		latest  string
+		modTime int64
+	)
+	for _, p := range paths {
+		fi, err := os.Stat(p)
+		if err != nil {
+			return "", err
+		}
+		if t := fi.ModTime().UnixNano(); latest == "" || t > modTime {
+			latest, modTime = p, t
+		}
+	}
+	if latest == "" {
+		return "", fmt.Errorf("no reports found in %s", dir)
+	}
+	return latest, nil
+}
@@ -0,0 +1,91 @@
+package conformance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+	"github.com/strangelove-ventures/interchaintest/v8/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// Number of packets sent in a burst, and how many of them are batched in a single tx.
+	burstPacketCount = 300
+	burstBatchSize   = 50
+
+	// Gas per transfer msg in a burst tx.
+	burstGasPerMsg = 100_000
+
+	// How long the relayer has to acknowledge the whole burst.
+	burstRelayTimeout = 10 * time.Minute
+)
+
+// TestRelayerPacketBurst asserts that the relayer relays and acknowledges hundreds of packets sent within a few blocks.
+func TestRelayerPacketBurst(t *testing.T, ctx context.Context, cf interchaintest.ChainFactory, rf interchaintest.RelayerFactory, rep *testreporter.Reporter) {
+	rep.TrackTest(t)
+
+	req := require.New(rep.TestifyT(t))
+
+	p := buildChainPair(t, ctx, cf, rf, rep, nil)
+	c0, c1 := p.cosmosChains(t, rep)
+
+	testCase := p.newTestCase(t, ctx, "packet burst")
+	sender := testCase.Users[0].(*cosmos.CosmosWallet)
+	receiver := sender.FormattedAddressWithPrefix(c1.Config().Bech32Prefix)
+
+	p.startRelayer(t, ctx)
+
+	b := cosmos.NewBroadcaster(t, c0)
+	b.ConfigureFactoryOptions(func(f tx.Factory) tx.Factory {
+		return f.WithGas(burstBatchSize * burstGasPerMsg)
+	})
+
+	timeout := uint64(time.Now().Add(burstRelayTimeout).UnixNano())
+	coin := sdk.NewCoin(c0.Config().Denom, testCoinAmount)
+	for sent := 0; sent < burstPacketCount; sent += burstBatchSize {
+		msgs := make([]sdk.Msg, burstBatchSize)
+		for i := range msgs {
+			msgs[i] = transfertypes.NewMsgTransfer(
+				p.channel.PortID, p.channel.ChannelID, coin, sender.FormattedAddress(), receiver, clienttypes.ZeroHeight(), timeout, "",
+			)
+		}
+		res, err := cosmos.BroadcastTx(ctx, b, sender, msgs...)
+		req.NoError(err, "failed to broadcast transfers")
+		req.Zero(res.Code, "transfers failed: %s", res.RawLog)
+	}
+
+	t.Run("relay burst", func(t *testing.T) {
+		rep.TrackTest(t)
+
+		req := require.New(rep.TestifyT(t))
+
+		// Once the relayer acknowledged all packets, their commitments are deleted on the sending chain.
+		queryClient := chantypes.NewQueryClient(c0.GetNode().GrpcConn)
+		err := testutil.WaitForCondition(burstRelayTimeout, 5*time.Second, func() (bool, error) {
+			res, err := queryClient.PacketCommitments(ctx, &chantypes.QueryPacketCommitmentsRequest{
+				PortId:    p.channel.PortID,
+				ChannelId: p.channel.ChannelID,
+			})
+			if err != nil {
+				return false, err
+			}
+			return len(res.Commitments) == 0, nil
+		})
+		req.NoError(err, "relayer did not acknowledge all packets")
+
+		denom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(p.channel.Counterparty.PortID, p.channel.Counterparty.ChannelID, c0.Config().Denom))
+		balance, err := c1.GetBalance(ctx, receiver, denom.IBCDenom())
+		req.NoError(err, "failed to get balance from destination chain")
+		req.True(balance.Equal(testCoinAmount.Mul(math.NewInt(burstPacketCount))), "unexpected balance %s", balance)
+	})
+}
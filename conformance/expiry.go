@@ -0,0 +1,112 @@
+package conformance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/relayer"
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+	"github.com/strangelove-ventures/interchaintest/v8/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// Trusting period of the clients that are left to expire.
+	expiryTrustingPeriod = "1m"
+
+	// Longest voting period for which the expired clients are recovered through governance.
+	expiryMaxVotingPeriod = 5 * time.Minute
+
+	// Path only used for creating the substitute clients.
+	substitutePathName = "substitute"
+)
+
+// TestRelayerClientExpiryRecovery lets the clients of a path expire, recovers them through governance
+// with substitute clients, and asserts that the relayer relays packets over the recovered clients.
+// The chains must have a voting period of at most five minutes.
+func TestRelayerClientExpiryRecovery(t *testing.T, ctx context.Context, cf interchaintest.ChainFactory, rf interchaintest.RelayerFactory, rep *testreporter.Reporter) {
+	rep.TrackTest(t)
+
+	requireCapabilities(t, rep, rf, relayer.ClientExpiryRecovery)
+
+	req := require.New(rep.TestifyT(t))
+
+	clientOpts := ibc.CreateClientOptions{TrustingPeriod: expiryTrustingPeriod}
+	p := buildChainPair(t, ctx, cf, rf, rep, func(link *interchaintest.InterchainLink) {
+		link.CreateClientOpts = clientOpts
+	})
+	c0, c1 := p.cosmosChains(t, rep)
+
+	for _, c := range []*cosmos.CosmosChain{c0, c1} {
+		params, err := c.GovQueryParams(ctx, "voting")
+		req.NoError(err, "failed to query gov params")
+		if params.VotingPeriod == nil || *params.VotingPeriod > expiryMaxVotingPeriod {
+			rep.TrackSkip(t, "skipping as the voting period of %s is too long to recover clients", c.Config().ChainID)
+		}
+	}
+
+	// The relayer has not been started, so nothing updates the clients.
+	subjects := make(map[*cosmos.CosmosChain]string, 2)
+	for _, c := range []*cosmos.CosmosChain{c0, c1} {
+		chainID := c.Config().ChainID
+		req.NoError(testutil.WaitForClientExpiry(ctx, p.r, p.eRep, pairPathName, chainID, clientOpts))
+
+		status, err := p.r.GetClientStatus(ctx, p.eRep, pairPathName, chainID)
+		req.NoError(err)
+		subjects[c] = status.ClientID
+	}
+
+	// The substitutes are created on a path of their own,
+	// which leaves the clients of the relayed path unchanged in the relayer configuration.
+	req.NoError(p.r.GeneratePath(ctx, p.eRep, c0.Config().ChainID, c1.Config().ChainID, substitutePathName))
+	req.NoError(p.r.CreateClients(ctx, p.eRep, substitutePathName, ibc.DefaultClientOpts()), "failed to create substitute clients")
+
+	for _, pair := range [][2]*cosmos.CosmosChain{{c0, c1}, {c1, c0}} {
+		c, counterparty := pair[0], pair[1]
+		chainID := c.Config().ChainID
+
+		clients, err := p.r.GetClients(ctx, p.eRep, chainID)
+		req.NoError(err)
+
+		var substitute string
+		for _, client := range clients {
+			if client.ClientID != subjects[c] && client.ClientState.ChainID == counterparty.Config().ChainID {
+				substitute = client.ClientID
+			}
+		}
+		req.NotEmpty(substitute, "no substitute client found on %s", chainID)
+
+		authority := sdk.MustBech32ifyAddressBytes(c.Config().Bech32Prefix, authtypes.NewModuleAddress(govtypes.ModuleName))
+		msg := clienttypes.NewMsgRecoverClient(authority, subjects[c], substitute)
+		_, err = c.PassProposal(ctx, []cosmos.ProtoMessage{msg}, cosmos.ProposalOptions{
+			KeyName: interchaintest.FaucetAccountKeyName,
+			Title:   "Recover expired client",
+			Summary: "Recover " + subjects[c] + " with " + substitute,
+		})
+		req.NoError(err, "failed to recover client on %s", chainID)
+
+		status, err := p.r.GetClientStatus(ctx, p.eRep, pairPathName, chainID)
+		req.NoError(err)
+		req.Equal(ibc.ClientStatusActive, status.Status, "client on %s was not recovered", chainID)
+	}
+
+	t.Run("relay after recovery", func(t *testing.T) {
+		rep.TrackTest(t)
+
+		testCase := p.newTestCase(t, ctx, "client expiry recovery")
+
+		p.startRelayer(t, ctx)
+
+		channels := []ibc.ChannelOutput{p.channel}
+		sendIBCTransfersFromBothChainsWithTimeout(ctx, t, testCase, p.c0, p.c1, channels, nil)
+		testPacketRelaySuccess(ctx, t, testCase, rep, p.c0, p.c1, channels)
+	})
+}
@@ -0,0 +1,166 @@
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	icahosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+	"github.com/strangelove-ventures/interchaintest/v8/testutil"
+)
+
+// icaHandshakeTimeout is how long the relayer has to open an interchain account channel.
+const icaHandshakeTimeout = 2 * time.Minute
+
+// icaChains returns the chains of the pair if the first can control interchain accounts on the second,
+// and otherwise tracks skipping t.
+func (p *chainPair) icaChains(t *testing.T, ctx context.Context, rep *testreporter.Reporter) (controller, host *cosmos.CosmosChain) {
+	t.Helper()
+
+	controller, host = p.cosmosChains(t, rep)
+
+	controllerParams, err := icacontrollertypes.NewQueryClient(controller.GetNode().GrpcConn).Params(ctx, &icacontrollertypes.QueryParamsRequest{})
+	if err != nil || !controllerParams.Params.ControllerEnabled {
+		rep.TrackSkip(t, "skipping as %s does not enable the interchain accounts controller", controller.Config().ChainID)
+	}
+
+	hostParams, err := icahosttypes.NewQueryClient(host.GetNode().GrpcConn).Params(ctx, &icahosttypes.QueryParamsRequest{})
+	if err != nil || !hostParams.Params.HostEnabled {
+		rep.TrackSkip(t, "skipping as %s does not enable the interchain accounts host", host.Config().ChainID)
+	}
+
+	return controller, host
+}
+
+// interchainAccount is an interchain account on host, controlled by owner on controller over connectionID.
+type interchainAccount struct {
+	controller, host *cosmos.CosmosChain
+	owner            *cosmos.CosmosWallet
+	connectionID     string
+	portID           string
+
+	// Address on host, known once the account was registered.
+	address string
+}
+
+func newInterchainAccount(controller, host *cosmos.CosmosChain, owner ibc.Wallet, connectionID string) (*interchainAccount, error) {
+	ownerWallet := owner.(*cosmos.CosmosWallet)
+	portID, err := icatypes.NewControllerPortID(ownerWallet.FormattedAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	return &interchainAccount{
+		controller:   controller,
+		host:         host,
+		owner:        ownerWallet,
+		connectionID: connectionID,
+		portID:       portID,
+	}, nil
+}
+
+// register starts the handshake of a new ordered channel for the account,
+// which also reopens the account after its previous channel closed.
+// The relayer needs to complete the handshake, see waitForOpenChannel.
+func (a *interchainAccount) register(ctx context.Context) error {
+	if _, err := a.controller.RegisterICA(ctx, a.owner.KeyName(), a.connectionID); err != nil {
+		return fmt.Errorf("failed to register interchain account: %w", err)
+	}
+	return nil
+}
+
+// latestChannel returns the most recently created channel of the account on the controller,
+// or nil if no channel exists yet.
+func (a *interchainAccount) latestChannel(ctx context.Context) (*chantypes.IdentifiedChannel, error) {
+	res, err := chantypes.NewQueryClient(a.controller.GetNode().GrpcConn).ConnectionChannels(ctx, &chantypes.QueryConnectionChannelsRequest{
+		Connection: a.connectionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		latest    *chantypes.IdentifiedChannel
+		latestSeq uint64
+	)
+	for _, channel := range res.Channels {
+		if channel.PortId != a.portID {
+			continue
+		}
+		seq, err := chantypes.ParseChannelSequence(channel.ChannelId)
+		if err != nil {
+			return nil, err
+		}
+		if latest == nil || seq > latestSeq {
+			latest, latestSeq = channel, seq
+		}
+	}
+	return latest, nil
+}
+
+// waitForOpenChannel waits for the relayer to open the latest channel of the account,
+// and then records the address of the account. It returns the ID of the open channel on the controller.
+func (a *interchainAccount) waitForOpenChannel(ctx context.Context) (string, error) {
+	var channelID string
+	err := testutil.WaitForCondition(icaHandshakeTimeout, time.Second, func() (bool, error) {
+		channel, err := a.latestChannel(ctx)
+		if err != nil || channel == nil {
+			return false, err
+		}
+		channelID = channel.ChannelId
+		return channel.State == chantypes.OPEN, nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("channel of interchain account was not opened: %w", err)
+	}
+
+	a.address, err = a.controller.QueryICAAddress(ctx, a.connectionID, a.owner.FormattedAddress())
+	if err != nil {
+		return "", fmt.Errorf("failed to query interchain account address: %w", err)
+	}
+	return channelID, nil
+}
+
+// send executes msgs with the account on the host, in a packet which times out after timeout.
+func (a *interchainAccount) send(ctx context.Context, timeout time.Duration, msgs ...proto.Message) (ibc.Tx, error) {
+	cdc := a.host.GetCodec()
+	data, err := icatypes.SerializeCosmosTx(cdc, msgs, icatypes.EncodingProtobuf)
+	if err != nil {
+		return ibc.Tx{}, err
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+	packetJSON, err := cdc.MarshalJSON(&packetData)
+	if err != nil {
+		return ibc.Tx{}, err
+	}
+
+	return a.controller.SendIBCPacket(ctx, a.owner.KeyName(),
+		"interchain-accounts", "controller", "send-tx", a.connectionID, string(packetJSON),
+		"--relative-packet-timeout", strconv.FormatInt(timeout.Nanoseconds(), 10),
+	)
+}
+
+// sendTokens sends testCoinAmount of the host denom from the account to recipient on the host.
+func (a *interchainAccount) sendTokens(ctx context.Context, recipient string, timeout time.Duration) (ibc.Tx, error) {
+	msg := &banktypes.MsgSend{
+		FromAddress: a.address,
+		ToAddress:   recipient,
+		Amount:      sdk.NewCoins(sdk.NewCoin(a.host.Config().Denom, testCoinAmount)),
+	}
+	return a.send(ctx, timeout, msg)
+}
@@ -0,0 +1,125 @@
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/relayer"
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+	"github.com/strangelove-ventures/interchaintest/v8/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// Key the relayer wallet is recovered under on the chain, so that it can be drained.
+	relayerWalletKeyName = "relayer-wallet"
+
+	// Number of blocks during which the drained relayer wallet fails to relay.
+	lowGasBlocks = 10
+)
+
+// TestRelayerLowGasFunding drains the relayer wallet on the second chain so that it cannot pay
+// for relaying packets, and asserts that the relayer relays the pending packets once the wallet is funded again.
+// The second chain must charge fees.
+func TestRelayerLowGasFunding(t *testing.T, ctx context.Context, cf interchaintest.ChainFactory, rf interchaintest.RelayerFactory, rep *testreporter.Reporter) {
+	rep.TrackTest(t)
+
+	requireCapabilities(t, rep, rf, relayer.LowGasRecovery)
+
+	req := require.New(rep.TestifyT(t))
+
+	p := buildChainPair(t, ctx, cf, rf, rep, nil)
+
+	c1Cfg := p.c1.Config()
+	gasPrice, err := math.LegacyNewDecFromStr(strings.TrimSuffix(c1Cfg.GasPrices, c1Cfg.Denom))
+	if err != nil || gasPrice.IsZero() {
+		rep.TrackSkip(t, "skipping as %s does not charge fees", c1Cfg.ChainID)
+	}
+
+	wallet, ok := p.r.GetWallet(c1Cfg.ChainID)
+	req.True(ok, "relayer has no wallet on %s", c1Cfg.ChainID)
+	req.NoError(p.c1.RecoverKey(ctx, relayerWalletKeyName, wallet.Mnemonic()))
+
+	funds, err := drainWallet(ctx, p.c1, relayerWalletKeyName, wallet.FormattedAddress())
+	req.NoError(err, "failed to drain relayer wallet")
+
+	p.startRelayer(t, ctx)
+
+	testCase := p.newTestCase(t, ctx, "low gas funding")
+	channels := []ibc.ChannelOutput{p.channel}
+	sendIBCTransfersFromBothChainsWithTimeout(ctx, t, testCase, p.c0, p.c1, channels, nil)
+
+	req.NoError(testutil.WaitForBlocks(ctx, lowGasBlocks, p.c0, p.c1))
+
+	receiver := testCase.Users[0].(*cosmos.CosmosWallet).FormattedAddressWithPrefix(c1Cfg.Bech32Prefix)
+	denom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(p.channel.Counterparty.PortID, p.channel.Counterparty.ChannelID, p.c0.Config().Denom))
+	received, err := p.c1.GetBalance(ctx, receiver, denom.IBCDenom())
+	req.NoError(err, "failed to get balance from destination chain")
+	req.True(received.IsZero(), "packet was received although the relayer wallet was drained")
+
+	req.NoError(p.c1.SendFunds(ctx, interchaintest.FaucetAccountKeyName, ibc.WalletAmount{
+		Address: wallet.FormattedAddress(),
+		Denom:   c1Cfg.Denom,
+		Amount:  funds,
+	}), "failed to fund relayer wallet")
+
+	t.Run("relay after funding", func(t *testing.T) {
+		rep.TrackTest(t)
+
+		testPacketRelaySuccess(ctx, t, testCase, rep, p.c0, p.c1, channels)
+	})
+}
+
+// drainWallet sends the funds of the wallet at address, whose key is keyName, to the faucet of c.
+// It leaves half the fee of a transfer in the wallet, so that the wallet cannot pay for transactions.
+// It returns the balance the wallet had before.
+func drainWallet(ctx context.Context, c ibc.Chain, keyName, address string) (math.Int, error) {
+	cfg := c.Config()
+
+	faucetAddrBytes, err := c.GetAddress(ctx, interchaintest.FaucetAccountKeyName)
+	if err != nil {
+		return math.Int{}, err
+	}
+	faucetAddr, err := types.Bech32ifyAddressBytes(cfg.Bech32Prefix, faucetAddrBytes)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	balance, err := c.GetBalance(ctx, address, cfg.Denom)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	// The first transfer keeps enough for its fee, and reveals the fee of the second one.
+	sent := balance.Sub(balance.QuoRaw(100))
+	if err := c.SendFunds(ctx, keyName, ibc.WalletAmount{Address: faucetAddr, Denom: cfg.Denom, Amount: sent}); err != nil {
+		return math.Int{}, err
+	}
+	remaining, err := c.GetBalance(ctx, address, cfg.Denom)
+	if err != nil {
+		return math.Int{}, err
+	}
+	fee := balance.Sub(sent).Sub(remaining)
+
+	leftover := fee.QuoRaw(2)
+	if err := c.SendFunds(ctx, keyName, ibc.WalletAmount{Address: faucetAddr, Denom: cfg.Denom, Amount: remaining.Sub(fee).Sub(leftover)}); err != nil {
+		return math.Int{}, err
+	}
+
+	drained, err := c.GetBalance(ctx, address, cfg.Denom)
+	if err != nil {
+		return math.Int{}, err
+	}
+	if drained.GTE(fee) {
+		return math.Int{}, fmt.Errorf("wallet still holds %s%s, enough to pay a fee of %s%s", drained, cfg.Denom, fee, cfg.Denom)
+	}
+	return balance, nil
+}
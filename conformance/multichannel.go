@@ -0,0 +1,52 @@
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+	"github.com/stretchr/testify/require"
+)
+
+// multiChannelCount is how many transfer channels are opened on the connection of the path.
+const multiChannelCount = 3
+
+// TestRelayerMultipleChannels asserts that the relayer relays packets on several channels sharing one connection.
+func TestRelayerMultipleChannels(t *testing.T, ctx context.Context, cf interchaintest.ChainFactory, rf interchaintest.RelayerFactory, rep *testreporter.Reporter) {
+	rep.TrackTest(t)
+
+	req := require.New(rep.TestifyT(t))
+
+	p := buildChainPair(t, ctx, cf, rf, rep, nil)
+
+	for i := 1; i < multiChannelCount; i++ {
+		req.NoError(p.r.CreateChannel(ctx, p.eRep, pairPathName, ibc.DefaultChannelOpts()), "failed to create channel")
+	}
+
+	channels, err := p.r.GetChannels(ctx, p.eRep, p.c0.Config().ChainID)
+	req.NoError(err)
+	req.Len(channels, multiChannelCount)
+	for _, channel := range channels {
+		req.Equal(p.channel.ConnectionHops, channel.ConnectionHops, "channel %s is not on the connection of the path", channel.ChannelID)
+	}
+
+	p.startRelayer(t, ctx)
+
+	// Each channel gets its own users, as testPacketRelaySuccess expects a single transfer per user and direction.
+	testCases := make([]*RelayerTestCase, len(channels))
+	for i, channel := range channels {
+		testCases[i] = p.newTestCase(t, ctx, fmt.Sprintf("multiple channels %d", i))
+		sendIBCTransfersFromBothChainsWithTimeout(ctx, t, testCases[i], p.c0, p.c1, []ibc.ChannelOutput{channel}, nil)
+	}
+
+	for i, channel := range channels {
+		i, channel := i, channel
+		t.Run(channel.ChannelID, func(t *testing.T) {
+			rep.TrackTest(t)
+			testPacketRelaySuccess(ctx, t, testCases[i], rep, p.c0, p.c1, []ibc.ChannelOutput{channel})
+		})
+	}
+}
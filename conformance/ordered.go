@@ -0,0 +1,146 @@
+package conformance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/relayer"
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+	"github.com/strangelove-ventures/interchaintest/v8/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// Number of packets sent over the ordered channel in a row.
+	orderedPacketCount = 3
+
+	// Relative timeout of interchain account packets which are expected to be relayed.
+	icaPacketTimeout = 10 * time.Minute
+)
+
+// TestRelayerOrderedChannels asserts the relaying of packets over an ordered channel,
+// and that the channel is closed once one of its packets times out.
+// Interchain accounts provide the ordered channel, so the chains must enable the controller and the host.
+// Afterwards, the closed channel of the interchain account is reopened.
+func TestRelayerOrderedChannels(t *testing.T, ctx context.Context, cf interchaintest.ChainFactory, rf interchaintest.RelayerFactory, rep *testreporter.Reporter) {
+	rep.TrackTest(t)
+
+	requireCapabilities(t, rep, rf, relayer.OrderedChannels)
+
+	req := require.New(rep.TestifyT(t))
+
+	p := buildChainPair(t, ctx, cf, rf, rep, nil)
+	controller, host := p.icaChains(t, ctx, rep)
+
+	testCase := p.newTestCase(t, ctx, "ordered channels")
+	recipient := testCase.Users[1].FormattedAddress()
+
+	ica, err := newInterchainAccount(controller, host, testCase.Users[0], p.channel.ConnectionHops[0])
+	req.NoError(err)
+
+	p.startRelayer(t, ctx)
+
+	req.NoError(ica.register(ctx))
+	channelID, err := ica.waitForOpenChannel(ctx)
+	req.NoError(err)
+
+	req.NoError(host.SendFunds(ctx, interchaintest.FaucetAccountKeyName, ibc.WalletAmount{
+		Address: ica.address,
+		Denom:   host.Config().Denom,
+		Amount:  userFaucetFund,
+	}), "failed to fund interchain account")
+
+	relayed := t.Run("relay ordered packets", func(t *testing.T) {
+		rep.TrackTest(t)
+
+		req := require.New(rep.TestifyT(t))
+
+		txs := make([]ibc.Tx, orderedPacketCount)
+		for i := range txs {
+			tx, err := ica.sendTokens(ctx, recipient, icaPacketTimeout)
+			req.NoError(err)
+			req.NoError(tx.Validate())
+			txs[i] = tx
+		}
+
+		for _, tx := range txs {
+			ack, err := testutil.PollForAck(ctx, controller, tx.Height, tx.Height+pollHeightMax, tx.Packet)
+			req.NoError(err, "failed to get acknowledgement on controller chain")
+			req.NoError(ack.Validate(), "invalid acknowledgement on controller chain")
+		}
+
+		balance, err := host.GetBalance(ctx, recipient, host.Config().Denom)
+		req.NoError(err, "failed to get balance from host chain")
+		req.True(balance.Equal(userFaucetFund.Add(testCoinAmount.MulRaw(orderedPacketCount))), "unexpected balance %s", balance)
+	})
+
+	closed := relayed && t.Run("timeout closes channel", func(t *testing.T) {
+		rep.TrackTest(t)
+
+		req := require.New(rep.TestifyT(t))
+
+		// Hold the packet back until it timed out.
+		req.NoError(p.r.StopRelayer(ctx, p.eRep), "failed to stop relayer")
+
+		tx, err := ica.sendTokens(ctx, recipient, time.Second)
+		req.NoError(err)
+		req.NoError(tx.Validate())
+
+		time.Sleep(15 * time.Second)
+
+		req.NoError(p.r.StartRelayer(ctx, p.eRep, pairPathName), "failed to start relayer")
+
+		timeout, err := testutil.PollForTimeout(ctx, controller, tx.Height, tx.Height+pollHeightMax, tx.Packet)
+		req.NoError(err, "failed to get timeout packet on controller chain")
+		req.NoError(timeout.Validate(), "invalid timeout packet on controller chain")
+
+		channel, err := controller.IBCQueryChannel(ctx, ica.portID, channelID)
+		req.NoError(err)
+		req.Equal(chantypes.CLOSED, channel.State, "ordered channel was not closed by the timeout")
+
+		err = testutil.WaitForCondition(icaHandshakeTimeout, time.Second, func() (bool, error) {
+			counterparty, err := host.IBCQueryChannel(ctx, icatypes.HostPortID, channel.Counterparty.ChannelId)
+			if err != nil {
+				return false, err
+			}
+			return counterparty.State == chantypes.CLOSED, nil
+		})
+		req.NoError(err, "counterparty of ordered channel was not closed")
+	})
+
+	t.Run("reopen interchain account channel", func(t *testing.T) {
+		rep.TrackTest(t)
+
+		requireCapabilities(t, rep, rf, relayer.ICAChannelReopen)
+		if !closed {
+			rep.TrackSkip(t, "skipping as the channel of the interchain account was not closed")
+		}
+
+		req := require.New(rep.TestifyT(t))
+
+		address := ica.address
+
+		req.NoError(ica.register(ctx))
+		reopenedID, err := ica.waitForOpenChannel(ctx)
+		req.NoError(err)
+		req.NotEqual(channelID, reopenedID, "no new channel was opened")
+		req.Equal(address, ica.address, "reopened channel controls a different account")
+
+		tx, err := ica.sendTokens(ctx, recipient, icaPacketTimeout)
+		req.NoError(err)
+		req.NoError(tx.Validate())
+
+		ack, err := testutil.PollForAck(ctx, controller, tx.Height, tx.Height+pollHeightMax, tx.Packet)
+		req.NoError(err, "failed to get acknowledgement on controller chain")
+		req.NoError(ack.Validate(), "invalid acknowledgement on controller chain")
+
+		balance, err := host.GetBalance(ctx, recipient, host.Config().Denom)
+		req.NoError(err, "failed to get balance from host chain")
+		req.True(balance.Equal(userFaucetFund.Add(testCoinAmount.MulRaw(orderedPacketCount+1))), "unexpected balance %s", balance)
+	})
+}
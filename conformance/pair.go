@@ -0,0 +1,153 @@
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v8/dockerutil"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/relayer"
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+	"github.com/stretchr/testify/require"
+)
+
+// pairPathName is the path linking the chains of a chainPair.
+const pairPathName = "p"
+
+// chainPair holds two started chains linked by a single path.
+// It backs the conformance tests which need an interchain of their own,
+// because they alter the relayer or the path in ways that would disturb the cases of TestChainPair.
+type chainPair struct {
+	c0, c1 ibc.Chain
+	r      ibc.Relayer
+	eRep   *testreporter.RelayerExecReporter
+
+	// Channel on c0 created for the path.
+	channel ibc.ChannelOutput
+}
+
+// buildChainPair starts the two chains of cf and links them through pairPathName, without starting the relayer.
+// If configure is not nil, it may adjust the link before the interchain is built.
+func buildChainPair(
+	t *testing.T,
+	ctx context.Context,
+	cf interchaintest.ChainFactory,
+	rf interchaintest.RelayerFactory,
+	rep *testreporter.Reporter,
+	configure func(*interchaintest.InterchainLink),
+) *chainPair {
+	client, network := interchaintest.DockerSetup(t)
+
+	req := require.New(rep.TestifyT(t))
+	chains, err := cf.Chains(t.Name())
+	req.NoError(err, "failed to get chains")
+
+	if len(chains) != 2 {
+		panic(fmt.Errorf("expected 2 chains, got %d", len(chains)))
+	}
+
+	c0, c1 := chains[0], chains[1]
+
+	r := rf.Build(t, client, network)
+
+	link := interchaintest.InterchainLink{
+		Chain1:  c0,
+		Chain2:  c1,
+		Relayer: r,
+
+		Path:              pairPathName,
+		CreateChannelOpts: ibc.DefaultChannelOpts(),
+	}
+	if configure != nil {
+		configure(&link)
+	}
+
+	ic := interchaintest.NewInterchain().
+		AddChain(c0).
+		AddChain(c1).
+		AddRelayer(r, "r").
+		AddLink(link)
+
+	eRep := rep.RelayerExecReporter(t)
+
+	req.NoError(ic.Build(ctx, eRep, interchaintest.InterchainBuildOptions{
+		TestName:  t.Name(),
+		Client:    client,
+		NetworkID: network,
+	}))
+	t.Cleanup(func() {
+		_ = ic.Close()
+	})
+
+	channels, err := r.GetChannels(ctx, eRep, c0.Config().ChainID)
+	req.NoError(err)
+	req.Len(channels, 1)
+
+	return &chainPair{
+		c0:      c0,
+		c1:      c1,
+		r:       r,
+		eRep:    eRep,
+		channel: channels[0],
+	}
+}
+
+// startRelayer starts relaying the path, and stops the relayer once t finishes.
+func (p *chainPair) startRelayer(t *testing.T, ctx context.Context) {
+	logRelayerErrorsOnFailure(t, p.r)
+	require.NoError(t, p.r.StartRelayer(ctx, p.eRep, pairPathName), "failed to start relayer")
+	t.Cleanup(func() {
+		if err := p.r.StopRelayer(ctx, p.eRep); err != nil {
+			t.Logf("error stopping relayer: %v", err)
+		}
+	})
+
+	// Wait for the relayer to start up.
+	time.Sleep(5 * time.Second)
+}
+
+// logRelayerErrorsOnFailure logs a summary of the errors the relayer logged once t finishes, if t failed.
+func logRelayerErrorsOnFailure(t *testing.T, r ibc.Relayer) {
+	src, ok := r.(relayer.LogEventSource)
+	if !ok {
+		return
+	}
+	t.Cleanup(func() {
+		if !t.Failed() {
+			return
+		}
+		if summary := src.LogEvents().Summary(); summary != "" {
+			t.Log(summary)
+		}
+	})
+}
+
+// newTestCase funds a user on each chain for a RelayerTestCase named name,
+// so that the transfers of the test case can be asserted with testPacketRelaySuccess.
+func (p *chainPair) newTestCase(t *testing.T, ctx context.Context, name string) *RelayerTestCase {
+	return &RelayerTestCase{
+		Config: RelayerTestCaseConfig{Name: name},
+		Users: interchaintest.GetAndFundTestUsers(
+			t, ctx, strings.ReplaceAll(name, " ", "-")+"-"+dockerutil.RandLowerCaseLetterString(4),
+			userFaucetFund, p.c0, p.c1,
+		),
+	}
+}
+
+// cosmosChains returns the chains of the pair if both are Cosmos chains,
+// and otherwise tracks skipping t since the test needs to query or transact on the chains directly.
+func (p *chainPair) cosmosChains(t *testing.T, rep *testreporter.Reporter) (*cosmos.CosmosChain, *cosmos.CosmosChain) {
+	t.Helper()
+
+	c0, ok0 := p.c0.(*cosmos.CosmosChain)
+	c1, ok1 := p.c1.(*cosmos.CosmosChain)
+	if !ok0 || !ok1 {
+		rep.TrackSkip(t, "skipping as the test requires two cosmos chains")
+	}
+	return c0, c1
+}
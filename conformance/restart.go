@@ -0,0 +1,88 @@
+package conformance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+	"github.com/strangelove-ventures/interchaintest/v8/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRelayerRestart asserts that the relayer picks up where it left off when it is restarted
+// while packets are pending, and while a channel handshake is in progress.
+func TestRelayerRestart(t *testing.T, ctx context.Context, cf interchaintest.ChainFactory, rf interchaintest.RelayerFactory, rep *testreporter.Reporter) {
+	rep.TrackTest(t)
+
+	p := buildChainPair(t, ctx, cf, rf, rep, nil)
+	channels := []ibc.ChannelOutput{p.channel}
+
+	p.startRelayer(t, ctx)
+
+	t.Run("pending packets", func(t *testing.T) {
+		rep.TrackTest(t)
+
+		req := require.New(rep.TestifyT(t))
+
+		// Packets sent right before stopping may or may not have been relayed yet,
+		// while packets sent while stopped are certainly pending once the relayer starts again.
+		beforeStop := p.newTestCase(t, ctx, "restart before stop")
+		whileStopped := p.newTestCase(t, ctx, "restart while stopped")
+
+		sendIBCTransfersFromBothChainsWithTimeout(ctx, t, beforeStop, p.c0, p.c1, channels, nil)
+		req.NoError(p.r.StopRelayer(ctx, p.eRep), "failed to stop relayer")
+
+		sendIBCTransfersFromBothChainsWithTimeout(ctx, t, whileStopped, p.c0, p.c1, channels, nil)
+		req.NoError(testutil.WaitForBlocks(ctx, 5, p.c0, p.c1))
+
+		req.NoError(p.r.StartRelayer(ctx, p.eRep, pairPathName), "failed to start relayer")
+
+		testPacketRelaySuccess(ctx, t, beforeStop, rep, p.c0, p.c1, channels)
+		testPacketRelaySuccess(ctx, t, whileStopped, rep, p.c0, p.c1, channels)
+	})
+
+	t.Run("handshake", func(t *testing.T) {
+		rep.TrackTest(t)
+
+		// A channel opened by the chain is needed to interrupt the relayer during the handshake,
+		// and interchain accounts open their channels on registration.
+		controller, host := p.icaChains(t, ctx, rep)
+
+		req := require.New(rep.TestifyT(t))
+
+		testCase := p.newTestCase(t, ctx, "restart handshake")
+		ica, err := newInterchainAccount(controller, host, testCase.Users[0], p.channel.ConnectionHops[0])
+		req.NoError(err)
+
+		// Registering waits for the tx to be committed, which gives the running relayer time to begin the handshake.
+		req.NoError(ica.register(ctx))
+		req.NoError(p.r.StopRelayer(ctx, p.eRep), "failed to stop relayer")
+
+		channel, err := ica.latestChannel(ctx)
+		req.NoError(err)
+		req.NotNil(channel, "interchain account channel was not initialized")
+		t.Logf("Stopped relayer with channel %s in state %s", channel.ChannelId, channel.State)
+
+		req.NoError(testutil.WaitForBlocks(ctx, 5, p.c0, p.c1))
+		req.NoError(p.r.StartRelayer(ctx, p.eRep, pairPathName), "failed to start relayer")
+
+		_, err = ica.waitForOpenChannel(ctx)
+		req.NoError(err)
+
+		req.NoError(host.SendFunds(ctx, interchaintest.FaucetAccountKeyName, ibc.WalletAmount{
+			Address: ica.address,
+			Denom:   host.Config().Denom,
+			Amount:  testCoinAmount,
+		}), "failed to fund interchain account")
+
+		tx, err := ica.sendTokens(ctx, testCase.Users[1].FormattedAddress(), icaPacketTimeout)
+		req.NoError(err)
+		req.NoError(tx.Validate())
+
+		ack, err := testutil.PollForAck(ctx, controller, tx.Height, tx.Height+pollHeightMax, tx.Packet)
+		req.NoError(err, "failed to get acknowledgement on controller chain")
+		req.NoError(ack.Validate(), "invalid acknowledgement on controller chain")
+	})
+}
@@ -263,6 +263,48 @@ func Test(t *testing.T, ctx context.Context, cfs []interchaintest.ChainFactory,
 
 								TestRelayerFlushing(t, ctx, cf, rf, rep)
 							})
+
+							t.Run("multiple channels", func(t *testing.T) {
+								rep.TrackTest(t)
+								rep.TrackParallel(t)
+
+								TestRelayerMultipleChannels(t, ctx, cf, rf, rep)
+							})
+
+							t.Run("packet burst", func(t *testing.T) {
+								rep.TrackTest(t)
+								rep.TrackParallel(t)
+
+								TestRelayerPacketBurst(t, ctx, cf, rf, rep)
+							})
+
+							t.Run("restart", func(t *testing.T) {
+								rep.TrackTest(t)
+								rep.TrackParallel(t)
+
+								TestRelayerRestart(t, ctx, cf, rf, rep)
+							})
+
+							t.Run("ordered channels", func(t *testing.T) {
+								rep.TrackTest(t)
+								rep.TrackParallel(t)
+
+								TestRelayerOrderedChannels(t, ctx, cf, rf, rep)
+							})
+
+							t.Run("client expiry recovery", func(t *testing.T) {
+								rep.TrackTest(t)
+								rep.TrackParallel(t)
+
+								TestRelayerClientExpiryRecovery(t, ctx, cf, rf, rep)
+							})
+
+							t.Run("low gas funding", func(t *testing.T) {
+								rep.TrackTest(t)
+								rep.TrackParallel(t)
+
+								TestRelayerLowGasFunding(t, ctx, cf, rf, rep)
+							})
 						})
 					}
 				})
@@ -332,6 +374,8 @@ func TestChainPair(
 		req.NoError(err, "failed to StartChainPair")
 	}
 
+	logRelayerErrorsOnFailure(t, relayerImpl)
+
 	// execute the pre relayer start functions, then start the relayer.
 	channels, err := interchaintest.StopStartRelayerWithPreStartFuncs(
 		t,
@@ -0,0 +1,71 @@
+package ibc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/relayer/rly"
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+// TestRelayerMetrics checks that the metrics of a started rly container can be scraped from the host.
+func TestRelayerMetrics(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in short mode")
+	}
+
+	t.Parallel()
+
+	ctx := context.Background()
+
+	cf := interchaintest.NewBuiltinChainFactory(zaptest.NewLogger(t), []*interchaintest.ChainSpec{
+		{Name: "gaia", Version: "v7.0.0", ChainConfig: ibc.ChainConfig{
+			GasPrices: "0.0uatom",
+		}},
+		{Name: "osmosis", Version: "v11.0.0"},
+	})
+
+	chains, err := cf.Chains(t.Name())
+	require.NoError(t, err)
+	gaia, osmosis := chains[0], chains[1]
+
+	client, network := interchaintest.DockerSetup(t)
+	r := interchaintest.NewBuiltinRelayerFactory(ibc.CosmosRly, zaptest.NewLogger(t)).Build(
+		t, client, network)
+
+	const ibcPath = "gaia-osmo-metrics"
+	ic := interchaintest.NewInterchain().
+		AddChain(gaia).
+		AddChain(osmosis).
+		AddRelayer(r, "relayer").
+		AddLink(interchaintest.InterchainLink{
+			Chain1:  gaia,
+			Chain2:  osmosis,
+			Relayer: r,
+			Path:    ibcPath,
+		})
+
+	eRep := testreporter.NewNopReporter().RelayerExecReporter(t)
+	require.NoError(t, ic.Build(ctx, eRep, interchaintest.InterchainBuildOptions{
+		TestName:  t.Name(),
+		Client:    client,
+		NetworkID: network,
+	}))
+	t.Cleanup(func() { _ = ic.Close() })
+
+	require.NoError(t, r.StartRelayer(ctx, eRep, ibcPath))
+	t.Cleanup(func() { _ = r.StopRelayer(ctx, eRep) })
+
+	metrics, err := r.(*rly.CosmosRelayer).Metrics()
+	require.NoError(t, err)
+	require.EventuallyWithT(t, func(c *assert.CollectT) {
+		_, err := metrics.Snapshot(ctx)
+		assert.NoError(c, err)
+	}, time.Minute, time.Second, "metrics of %s could not be scraped", metrics.URL())
+}
@@ -0,0 +1,48 @@
+package ibc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// DecodePacketData unmarshals the JSON encoded data of packet into T.
+// Most applications, including ICS-20 and ICS-721, encode their packet data as JSON,
+// so T is e.g. transfertypes.FungibleTokenPacketData or NonFungibleTokenPacketData.
+func DecodePacketData[T any](packet Packet) (T, error) {
+	var data T
+	if err := json.Unmarshal(packet.Data, &data); err != nil {
+		return data, fmt.Errorf("decode data of packet %d on %s/%s: %w", packet.Sequence, packet.SourcePort, packet.SourceChannel, err)
+	}
+	return data, nil
+}
+
+// Acknowledgement is the acknowledgement envelope defined by ICS-4,
+// written by ICS-20, ICS-721 and most other applications.
+// Exactly one of Result or Error is set.
+type Acknowledgement struct {
+	Result []byte `json:"result,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Success reports whether the packet was processed successfully by the receiving application.
+func (ack Acknowledgement) Success() bool {
+	return ack.Error == "" && len(ack.Result) > 0
+}
+
+// DecodeAcknowledgement unmarshals the JSON encoded acknowledgement written by the receiving application.
+func DecodeAcknowledgement(bz []byte) (Acknowledgement, error) {
+	var ack Acknowledgement
+	if err := json.Unmarshal(bz, &ack); err != nil {
+		return ack, fmt.Errorf("decode acknowledgement: %w", err)
+	}
+	if ack.Error == "" && len(ack.Result) == 0 {
+		return ack, errors.New("acknowledgement has neither a result nor an error")
+	}
+	return ack, nil
+}
+
+// Decode unmarshals the acknowledgement written by the receiving application.
+func (ack PacketAcknowledgement) Decode() (Acknowledgement, error) {
+	return DecodeAcknowledgement(ack.Acknowledgement)
+}
@@ -0,0 +1,82 @@
+package ibc
+
+import (
+	"testing"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodePacketData(t *testing.T) {
+	t.Run("ics-20", func(t *testing.T) {
+		packet := validPacket()
+		packet.Data = []byte(`{"amount":"100","denom":"uatom","memo":"hi","receiver":"osmo1abc","sender":"cosmos1abc"}`)
+
+		data, err := DecodePacketData[transfertypes.FungibleTokenPacketData](packet)
+
+		require.NoError(t, err)
+		require.Equal(t, transfertypes.FungibleTokenPacketData{
+			Denom:    "uatom",
+			Amount:   "100",
+			Sender:   "cosmos1abc",
+			Receiver: "osmo1abc",
+			Memo:     "hi",
+		}, data)
+	})
+
+	t.Run("ics-721", func(t *testing.T) {
+		packet := validPacket()
+		packet.SourcePort = "nft-transfer"
+		packet.Data = []byte(`{"classId":"wasm.juno1ics721/channel-0/juno1cw721","tokenIds":["1","2"],"sender":"juno1abc","receiver":"stars1abc"}`)
+
+		data, err := DecodePacketData[NonFungibleTokenPacketData](packet)
+
+		require.NoError(t, err)
+		require.Equal(t, "wasm.juno1ics721/channel-0/juno1cw721", data.ClassID)
+		require.Equal(t, []string{"1", "2"}, data.TokenIDs)
+		require.Equal(t, "juno1abc", data.Sender)
+		require.Equal(t, "stars1abc", data.Receiver)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := DecodePacketData[NonFungibleTokenPacketData](validPacket())
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "decode data of packet 1 on transfer/channel-0")
+	})
+}
+
+func TestDecodeAcknowledgement(t *testing.T) {
+	t.Run("result", func(t *testing.T) {
+		ack, err := PacketAcknowledgement{Packet: validPacket(), Acknowledgement: []byte(`{"result":"AQ=="}`)}.Decode()
+
+		require.NoError(t, err)
+		require.True(t, ack.Success())
+		require.Equal(t, []byte{1}, ack.Result)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		ack, err := DecodeAcknowledgement([]byte(`{"error":"ABCI code: 1: error handling packet: see events for details"}`))
+
+		require.NoError(t, err)
+		require.False(t, ack.Success())
+		require.Contains(t, ack.Error, "error handling packet")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := DecodeAcknowledgement([]byte(`{}`))
+		require.Error(t, err)
+
+		_, err = DecodeAcknowledgement([]byte(`not json`))
+		require.Error(t, err)
+	})
+}
+
+func TestClassID(t *testing.T) {
+	classID := GetPrefixedClassID("wasm.stars1ics721", "channel-3", "juno1cw721")
+	require.Equal(t, "wasm.stars1ics721/channel-3/juno1cw721", classID)
+
+	require.True(t, ClassIDIsReturning("wasm.stars1ics721", "channel-3", classID))
+	require.False(t, ClassIDIsReturning("wasm.stars1ics721", "channel-4", classID))
+	require.False(t, ClassIDIsReturning("wasm.juno1ics721", "channel-0", "juno1cw721"))
+}
@@ -0,0 +1,30 @@
+package ibc
+
+import "strings"
+
+// NonFungibleTokenPacketData is the packet data of an ICS-721 NFT transfer.
+// See: https://github.com/cosmos/ibc/tree/main/spec/app/ics-721-nft-transfer
+type NonFungibleTokenPacketData struct {
+	ClassID   string   `json:"classId"`
+	ClassURI  string   `json:"classUri,omitempty"`
+	ClassData string   `json:"classData,omitempty"`
+	TokenIDs  []string `json:"tokenIds"`
+	TokenURIs []string `json:"tokenUris,omitempty"`
+	TokenData []string `json:"tokenData,omitempty"`
+	Sender    string   `json:"sender"`
+	Receiver  string   `json:"receiver"`
+	Memo      string   `json:"memo,omitempty"`
+}
+
+// GetPrefixedClassID returns the class ID that an NFT class is known by
+// after being received over the given port and channel on the destination chain.
+func GetPrefixedClassID(destPort, destChannel, classID string) string {
+	return destPort + "/" + destChannel + "/" + classID
+}
+
+// ClassIDIsReturning reports whether sending an NFT of classID over the given source port and channel
+// returns it to the chain it originally came from, in which case it is released from escrow there
+// rather than minted as a new voucher.
+func ClassIDIsReturning(sourcePort, sourceChannel, classID string) bool {
+	return strings.HasPrefix(classID, sourcePort+"/"+sourceChannel+"/")
+}
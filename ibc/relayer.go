@@ -56,6 +56,9 @@ type Relayer interface {
 	// GetClients returns a slice of IBC client details composed of the details for each client on a specified chain.
 	GetClients(ctx context.Context, rep RelayerExecReporter, chainID string) (ClientOutputs, error)
 
+	// GetClientStatus returns the status of the client on chainID which tracks the counterparty chain of the path.
+	GetClientStatus(ctx context.Context, rep RelayerExecReporter, pathName, chainID string) (ClientStatusOutput, error)
+
 	// After configuration is initialized, begin relaying.
 	// This method is intended to create a background worker that runs the relayer.
 	// You must call StopRelayer to cleanly stop the relaying.
@@ -89,6 +92,11 @@ type Relayer interface {
 	// CreateChannel creates a channel on the given path with the provided options.
 	CreateChannel(ctx context.Context, rep RelayerExecReporter, pathName string, opts CreateChannelOptions) error
 
+	// UpgradeChannel completes the channel upgrade handshake for channelID on the source chain of the path.
+	// The upgrade must already have been initiated on the source chain, e.g. through a MsgChannelUpgradeInit
+	// submitted by governance or the chain's IBC authority. It returns once the upgrade is open on both ends.
+	UpgradeChannel(ctx context.Context, rep RelayerExecReporter, pathName, channelID string) error
+
 	// UseDockerNetwork reports whether the relayer is run in the same docker network as the other chains.
 	//
 	// If false, the relayer will connect to the localhost-exposed ports instead of the docker hosts.
@@ -62,3 +62,20 @@ func TestClientOptsConfigured(t *testing.T) {
 	}
 	require.Error(t, opts.Validate())
 }
+
+func TestRelayerPathConfig_Validate(t *testing.T) {
+	require.NoError(t, RelayerPathConfig{}.Validate("chain-a", "chain-b"))
+	require.NoError(t, RelayerPathConfig{
+		Filter:   ChannelFilter{Rule: ChannelFilterAllowlist, ChannelList: []string{"channel-0"}},
+		ChainGas: map[string]ChainGasConfig{"chain-b": {GasPrices: "0.025uatom"}},
+	}.Validate("chain-a", "chain-b"))
+
+	require.Error(t, RelayerPathConfig{Filter: ChannelFilter{Rule: "allow"}}.Validate("chain-a", "chain-b"))
+	require.Error(t, RelayerPathConfig{MaxMsgsPerTx: -1}.Validate("chain-a", "chain-b"))
+	require.Error(t, RelayerPathConfig{
+		ChainGas: map[string]ChainGasConfig{"chain-c": {MaxGas: 1}},
+	}.Validate("chain-a", "chain-b"))
+	require.Error(t, RelayerPathConfig{
+		ChainGas: map[string]ChainGasConfig{"chain-a": {GasPrices: "cheap"}},
+	}.Validate("chain-a", "chain-b"))
+}
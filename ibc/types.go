@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -371,6 +372,24 @@ type ClientState struct {
 
 type ClientOutputs []*ClientOutput
 
+// ClientStatus is the status of a light client as reported by the chain hosting it.
+type ClientStatus string
+
+const (
+	ClientStatusActive  ClientStatus = "Active"
+	ClientStatusExpired ClientStatus = "Expired"
+	ClientStatusFrozen  ClientStatus = "Frozen"
+	ClientStatusUnknown ClientStatus = "Unknown"
+)
+
+// ClientStatusOutput represents the status of the light client a chain uses to track the counterparty on a path.
+type ClientStatusOutput struct {
+	ClientID string
+	// ChainID is the chain hosting the client.
+	ChainID string
+	Status  ClientStatus
+}
+
 type Wallet interface {
 	KeyName() string
 	FormattedAddress() string
@@ -384,6 +403,9 @@ const (
 	CosmosRly RelayerImplementation = iota
 	Hermes
 	Hyperspace
+
+	// CosmosRlyHost runs a local rly binary as a host process instead of in a docker container.
+	CosmosRlyHost
 )
 
 // ChannelFilter provides the means for either creating an allowlist or a denylist of channels on the src chain
@@ -392,3 +414,66 @@ type ChannelFilter struct {
 	Rule        string
 	ChannelList []string
 }
+
+// Rules of a ChannelFilter.
+const (
+	ChannelFilterAllowlist = "allowlist"
+	ChannelFilterDenylist  = "denylist"
+)
+
+// RelayerPathConfig holds the relayer-agnostic settings of a path, such as the tuning of a production relayer.
+// It is applied by relayers implementing relayer.PathConfigurer, before the path is started.
+// Zero values keep the defaults of the relayer.
+type RelayerPathConfig struct {
+	// Filter narrows down the channels of the source chain of the path which are relayed.
+	Filter ChannelFilter
+
+	// ClearInterval is how often pending packets are cleared, in addition to relaying packets as they are sent.
+	// Hermes counts it in blocks, so it is converted with the block time of the slower chain of the path,
+	// taken from the CometMock block time or the timeout_commit of the config.toml overrides, and rounded down.
+	ClearInterval time.Duration
+
+	// MaxMsgsPerTx is the maximum number of messages the relayer batches in a transaction.
+	MaxMsgsPerTx int
+
+	// Memo is set on the transactions submitted by the relayer.
+	Memo string
+
+	// ChainGas holds the gas settings of the chains of the path, by chain ID.
+	ChainGas map[string]ChainGasConfig
+}
+
+// ChainGasConfig holds the gas settings a relayer uses for the transactions it submits to a chain.
+type ChainGasConfig struct {
+	// GasPrices is the price of gas, e.g. "0.025uatom".
+	GasPrices string
+
+	// GasAdjustment multiplies the simulated gas of a transaction.
+	GasAdjustment float64
+
+	// MaxGas caps the gas of a transaction.
+	MaxGas uint64
+}
+
+// Validate returns an error if c holds settings which cannot apply to the path between srcChainID and dstChainID.
+func (c RelayerPathConfig) Validate(srcChainID, dstChainID string) error {
+	switch c.Filter.Rule {
+	case "", ChannelFilterAllowlist, ChannelFilterDenylist:
+	default:
+		return fmt.Errorf("invalid channel filter rule %q, expected %q or %q", c.Filter.Rule, ChannelFilterAllowlist, ChannelFilterDenylist)
+	}
+	if c.ClearInterval < 0 || c.MaxMsgsPerTx < 0 {
+		return fmt.Errorf("clear interval and max msgs per tx must not be negative")
+	}
+	for chainID, gas := range c.ChainGas {
+		if chainID != srcChainID && chainID != dstChainID {
+			return fmt.Errorf("chain %s is not part of the path between %s and %s", chainID, srcChainID, dstChainID)
+		}
+		if gas.GasPrices != "" {
+			if _, err := sdk.ParseDecCoin(gas.GasPrices); err != nil {
+				return fmt.Errorf("invalid gas prices for chain %s: %w", chainID, err)
+			}
+		}
+	}
+	return nil
+}
@@ -30,7 +30,7 @@ type Interchain struct {
 	built bool
 
 	// Map of relayer-chain pairs to address and mnemonic, set during Build().
-	// Not yet exposed through any exported API.
+	// Exposed through RelayerWallets.
 	relayerWallets map[relayerChain]ibc.Wallet
 
 	// Map of chain to additional genesis wallets to include at chain start.
@@ -51,6 +51,10 @@ type interchainLink struct {
 	// If a zero value initialization is used, e.g. CreateChannelOptions{},
 	// then the default values will be used via ibc.DefaultChannelOpts.
 	createChannelOpts ibc.CreateChannelOptions
+
+	// If set, the wasm light client code is stored on its chain during Build
+	// and the relayer creates the client on that chain from the stored code.
+	wasmClientCode WasmClientCode
 }
 
 // NewInterchain returns a new Interchain.
@@ -153,6 +157,11 @@ type InterchainLink struct {
 	// If a zero value initialization is used, e.g. CreateChannelOptions{},
 	// then the default values will be used via ibc.DefaultChannelOpts.
 	CreateChannelOpts ibc.CreateChannelOptions
+
+	// If set, the 08-wasm light client code in WasmClientCode.File is stored on WasmClientCode.Chain
+	// through governance during Build, and the relayer is given the resulting checksum
+	// so that it creates a wasm client on that chain when linking the path.
+	WasmClientCode WasmClientCode
 }
 
 // AddLink adds the given link to the Interchain.
@@ -174,6 +183,10 @@ func (ic *Interchain) AddLink(link InterchainLink) *Interchain {
 		panic(fmt.Errorf("chains must be different (both were %v)", link.Chain1))
 	}
 
+	if link.WasmClientCode.File != "" && link.WasmClientCode.Chain != link.Chain1 && link.WasmClientCode.Chain != link.Chain2 {
+		panic(fmt.Errorf("wasm client chain %v is not part of path %q", link.WasmClientCode.Chain, link.Path))
+	}
+
 	key := relayerPath{
 		Relayer: link.Relayer,
 		Path:    link.Path,
@@ -187,6 +200,7 @@ func (ic *Interchain) AddLink(link InterchainLink) *Interchain {
 		chains:            [2]ibc.Chain{link.Chain1, link.Chain2},
 		createChannelOpts: link.CreateChannelOpts,
 		createClientOpts:  link.CreateClientOpts,
+		wasmClientCode:    link.WasmClientCode,
 	}
 	return ic
 }
@@ -265,6 +279,11 @@ func (ic *Interchain) Build(ctx context.Context, rep *testreporter.RelayerExecRe
 		return err
 	}
 
+	if err := ic.storeWasmClientCode(ctx, rep); err != nil {
+		// Error already wrapped with appropriate detail.
+		return err
+	}
+
 	// Some tests may want to configure the relayer from a lower level,
 	// but still have wallets configured.
 	if opts.SkipPathCreation {
@@ -442,6 +442,35 @@ func TestInterchain_ConflictRejection(t *testing.T) {
 	})
 }
 
+func TestInterchain_WasmClientChain(t *testing.T) {
+	cf := interchaintest.NewBuiltinChainFactory(zap.NewNop(), []*interchaintest.ChainSpec{
+		{Name: "gaia", ChainName: "g1", Version: "v7.0.1", ChainConfig: ibc.ChainConfig{ChainID: "cosmoshub-0"}},
+		{Name: "gaia", ChainName: "g2", Version: "v7.0.1", ChainConfig: ibc.ChainConfig{ChainID: "cosmoshub-1"}},
+		{Name: "gaia", ChainName: "g3", Version: "v7.0.1", ChainConfig: ibc.ChainConfig{ChainID: "cosmoshub-2"}},
+	})
+
+	chains, err := cf.Chains(t.Name())
+	require.NoError(t, err)
+
+	var r rly.CosmosRelayer
+	ic := interchaintest.NewInterchain().
+		AddChain(chains[0]).
+		AddChain(chains[1]).
+		AddChain(chains[2]).
+		AddRelayer(&r, "r")
+
+	exp := fmt.Sprintf("wasm client chain %v is not part of path %q", chains[2], "p")
+	require.PanicsWithError(t, exp, func() {
+		_ = ic.AddLink(interchaintest.InterchainLink{
+			Chain1:         chains[0],
+			Chain2:         chains[1],
+			Relayer:        &r,
+			Path:           "p",
+			WasmClientCode: interchaintest.WasmClientCode{Chain: chains[2], File: "client.wasm"},
+		})
+	})
+}
+
 func TestInterchain_AddNil(t *testing.T) {
 	require.PanicsWithError(t, "cannot add nil chain", func() {
 		_ = interchaintest.NewInterchain().AddChain(nil)
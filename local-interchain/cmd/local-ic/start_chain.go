@@ -20,6 +20,8 @@ const (
 	FlagRelayerVersion      = "relayer-version"
 	FlagRelayerUidGid       = "relayer-uidgid"
 	FlagRelayerStartupFlags = "relayer-startup-flags"
+	FlagRelayerMinBalance   = "relayer-min-balance"
+	FlagRelayerTopUp        = "relayer-top-up"
 	FlagAuthKey             = "auth-key"
 )
 
@@ -77,6 +79,8 @@ local-ic start https://pastebin.com/raw/Ummk4DTM
 		relayerVer := cmd.Flag(FlagRelayerVersion).Value.String()
 		relayerUidGid := cmd.Flag(FlagRelayerUidGid).Value.String()
 		relayerFlags := strings.Split(cmd.Flag(FlagRelayerStartupFlags).Value.String(), " ")
+		relayerMinBalance, _ := cmd.Flags().GetInt64(FlagRelayerMinBalance)
+		relayerTopUp, _ := cmd.Flags().GetInt64(FlagRelayerTopUp)
 
 		interchain.StartChain(parentDir, configPath, &types.AppStartConfig{
 			Address: apiAddr,
@@ -90,6 +94,8 @@ local-ic start https://pastebin.com/raw/Ummk4DTM
 					UidGid:     relayerUidGid,
 				},
 				StartupFlags: relayerFlags,
+				MinBalance:   relayerMinBalance,
+				TopUp:        relayerTopUp,
 			},
 
 			AuthKey: cmd.Flag(FlagAuthKey).Value.String(),
@@ -126,6 +132,8 @@ func init() {
 	startCmd.Flags().String(FlagRelayerVersion, "latest", "override the default relayer version")
 	startCmd.Flags().String(FlagRelayerUidGid, "100:1000", "override the default image UID:GID")
 	startCmd.Flags().String(FlagRelayerStartupFlags, "--block-history=100", "override the default relayer startup flags")
+	startCmd.Flags().Int64(FlagRelayerMinBalance, 0, "log when a relayer wallet balance falls below this amount of the chain denom (0 disables monitoring)")
+	startCmd.Flags().Int64(FlagRelayerTopUp, 0, "amount the faucet sends to a relayer wallet below the min balance (0 disables top-ups)")
 
 	startCmd.Flags().String(FlagAuthKey, "", "require an auth key to use the internal API")
 }
@@ -9,6 +9,7 @@ import (
 	"path"
 	"strings"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/strangelove-ventures/interchaintest/v8"
 	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
 	"github.com/strangelove-ventures/interchaintest/v8/ibc"
@@ -151,6 +152,18 @@ func StartChain(installDir, chainCfgFile string, ac *types.AppStartConfig) {
 				log.Fatal("relayer.StopRelayer", err)
 			}
 		}()
+
+		if config.Relayer.MinBalance > 0 {
+			opts := interchaintest.RelayerWalletMonitorOptions{
+				Threshold:   sdkmath.NewInt(config.Relayer.MinBalance),
+				TopUpAmount: sdkmath.NewInt(config.Relayer.TopUp),
+			}
+			monitor, err := ic.WithLog(logger).MonitorRelayerWallets(ctx, opts)
+			if err != nil {
+				log.Fatal("ic.MonitorRelayerWallets", err)
+			}
+			defer func() { _ = monitor.Stop() }()
+		}
 	}
 
 	for _, chain := range chains {
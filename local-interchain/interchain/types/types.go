@@ -34,6 +34,11 @@ type DockerImage struct {
 type Relayer struct {
 	DockerImage  DockerImage `json:"docker_image" yaml:"docker_image"`
 	StartupFlags []string    `json:"startup_flags" yaml:"startup_flags"`
+
+	// If positive, the relayer wallets are monitored for balances below MinBalance,
+	// and topped up by TopUp if positive.
+	MinBalance int64 `json:"min_balance,omitempty" yaml:"min_balance,omitempty"`
+	TopUp      int64 `json:"top_up,omitempty" yaml:"top_up,omitempty"`
 }
 
 type IBCChannel struct {
@@ -17,6 +17,23 @@ const (
 
 	// Whether the relayer supports a one-off flush command.
 	Flush
+
+	// Whether the relayer can complete an ICS-04 channel upgrade handshake.
+	ChannelUpgrade
+
+	// Whether the relayer relays packets over ordered channels,
+	// and closes an ordered channel when one of its packets times out.
+	OrderedChannels
+
+	// Whether the relayer resumes relaying over clients that were recovered by governance after expiring.
+	ClientExpiryRecovery
+
+	// Whether the relayer completes the handshake of an interchain account channel
+	// reopened by the controller chain after the previous channel closed.
+	ICAChannelReopen
+
+	// Whether the relayer resumes relaying once a wallet that could not pay for its transactions is funded again.
+	LowGasRecovery
 )
 
 // FullCapabilities returns a mapping of all known relayer features to true,
@@ -29,5 +46,12 @@ func FullCapabilities() map[Capability]bool {
 		HeightTimeout:    true,
 
 		Flush: true,
+
+		ChannelUpgrade: true,
+
+		OrderedChannels:      true,
+		ClientExpiryRecovery: true,
+		ICAChannelReopen:     true,
+		LowGasRecovery:       true,
 	}
 }
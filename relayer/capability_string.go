@@ -11,11 +11,16 @@ func _() {
 	_ = x[TimestampTimeout-0]
 	_ = x[HeightTimeout-1]
 	_ = x[Flush-2]
+	_ = x[ChannelUpgrade-3]
+	_ = x[OrderedChannels-4]
+	_ = x[ClientExpiryRecovery-5]
+	_ = x[ICAChannelReopen-6]
+	_ = x[LowGasRecovery-7]
 }
 
-const _Capability_name = "TimestampTimeoutHeightTimeoutFlush"
+const _Capability_name = "TimestampTimeoutHeightTimeoutFlushChannelUpgradeOrderedChannelsClientExpiryRecoveryICAChannelReopenLowGasRecovery"
 
-var _Capability_index = [...]uint8{0, 16, 29, 34}
+var _Capability_index = [...]uint8{0, 16, 29, 34, 48, 63, 83, 99, 113}
 
 func (i Capability) String() string {
 	if i < 0 || i >= Capability(len(_Capability_index)-1) {
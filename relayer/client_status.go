@@ -0,0 +1,59 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+)
+
+// ClientStateCommander is implemented by a RelayerCommander whose client status output does not tell frozen
+// clients apart, e.g. because it only tracks expiry. The frozen height of the client state then decides
+// whether a client reported as ClientStatusUnknown is active, and whether any client is frozen.
+type ClientStateCommander interface {
+	// GetClientState is the command to query the state of clientID hosted on chainID.
+	GetClientState(chainID, clientID, homeDir string) []string
+
+	// ParseClientFrozen processes the output of GetClientState to tell whether the client is frozen.
+	ParseClientFrozen(stdout, stderr string) (bool, error)
+}
+
+// clientStatusExecer is the part of a relayer needed by clientStatus.
+type clientStatusExecer interface {
+	Exec(ctx context.Context, rep ibc.RelayerExecReporter, cmd []string, env []string) ibc.RelayerExecResult
+	HomeDir() string
+}
+
+// clientStatus returns the status of the client on chainID for pathName through the commands of c,
+// with the frozen state of the client checked if c implements ClientStateCommander.
+func clientStatus(ctx context.Context, r clientStatusExecer, c RelayerCommander, rep ibc.RelayerExecReporter, pathName, chainID string) (ibc.ClientStatusOutput, error) {
+	res := r.Exec(ctx, rep, c.GetClientStatus(pathName, chainID, r.HomeDir()), nil)
+	if res.Err != nil {
+		return ibc.ClientStatusOutput{}, res.Err
+	}
+	out, err := c.ParseGetClientStatusOutput(chainID, string(res.Stdout), string(res.Stderr))
+	if err != nil {
+		return out, err
+	}
+
+	sc, ok := c.(ClientStateCommander)
+	if !ok || out.Status == ibc.ClientStatusFrozen {
+		return out, nil
+	}
+	res = r.Exec(ctx, rep, sc.GetClientState(chainID, out.ClientID, r.HomeDir()), nil)
+	if res.Err != nil {
+		return out, fmt.Errorf("query state of client %s: %w", out.ClientID, res.Err)
+	}
+	frozen, err := sc.ParseClientFrozen(string(res.Stdout), string(res.Stderr))
+	if err != nil {
+		return out, fmt.Errorf("parse state of client %s: %w", out.ClientID, err)
+	}
+	switch {
+	case frozen:
+		// A frozen client is frozen whether it expired or not, like the status the chain reports.
+		out.Status = ibc.ClientStatusFrozen
+	case out.Status == ibc.ClientStatusUnknown:
+		out.Status = ibc.ClientStatusActive
+	}
+	return out, nil
+}
@@ -0,0 +1,66 @@
+package relayer_test
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/relayer"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// statusCommander reports status for the client, like a relayer which only tracks expiry.
+type statusCommander struct {
+	shCommander
+	status ibc.ClientStatus
+}
+
+func (c statusCommander) GetClientStatus(pathName, chainID, homeDir string) []string {
+	return []string{"rly", "-c", "echo " + string(c.status)}
+}
+
+func (statusCommander) ParseGetClientStatusOutput(chainID, stdout, stderr string) (ibc.ClientStatusOutput, error) {
+	return ibc.ClientStatusOutput{ClientID: "07-tendermint-0", ChainID: chainID, Status: ibc.ClientStatus(strings.TrimSpace(stdout))}, nil
+}
+
+// clientStateCommander also reports whether the client is frozen, through its client state.
+type clientStateCommander struct {
+	statusCommander
+	frozen bool
+}
+
+func (c clientStateCommander) GetClientState(chainID, clientID, homeDir string) []string {
+	return []string{"rly", "-c", "echo " + strconv.FormatBool(c.frozen)}
+}
+
+func (clientStateCommander) ParseClientFrozen(stdout, stderr string) (bool, error) {
+	return strconv.ParseBool(strings.TrimSpace(stdout))
+}
+
+func TestGetClientStatus(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name string
+		c    relayer.RelayerCommander
+		want ibc.ClientStatus
+	}{
+		{"without client state", statusCommander{status: ibc.ClientStatusUnknown}, ibc.ClientStatusUnknown},
+		{"active", clientStateCommander{statusCommander{status: ibc.ClientStatusUnknown}, false}, ibc.ClientStatusActive},
+		{"frozen", clientStateCommander{statusCommander{status: ibc.ClientStatusUnknown}, true}, ibc.ClientStatusFrozen},
+		{"expired", clientStateCommander{statusCommander{status: ibc.ClientStatusExpired}, false}, ibc.ClientStatusExpired},
+		{"expired and frozen", clientStateCommander{statusCommander{status: ibc.ClientStatusExpired}, true}, ibc.ClientStatusFrozen},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := relayer.NewHostRelayer(ctx, zap.NewNop(), t.Name(), "sh", t.TempDir(), tt.c)
+			require.NoError(t, err)
+
+			status, err := r.GetClientStatus(ctx, ibc.NopRelayerExecReporter{}, "path", "chain-a")
+			require.NoError(t, err)
+			require.Equal(t, ibc.ClientStatusOutput{ClientID: "07-tendermint-0", ChainID: "chain-a", Status: tt.want}, status)
+		})
+	}
+}
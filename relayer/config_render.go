@@ -0,0 +1,31 @@
+package relayer
+
+import "github.com/strangelove-ventures/interchaintest/v8/ibc"
+
+// ConfigRenderer renders the config files which a relayer writes for its chains and paths,
+// through AddChainConfiguration and GeneratePath, without running the relayer or docker.
+// This allows snapshotting the generated configs, so that changes to their shape are noticed.
+type ConfigRenderer interface {
+	RenderConfig(chains []ConfigChain, paths []ConfigPath) ([]ConfigFile, error)
+}
+
+// ConfigChain holds the arguments of AddChainConfiguration for a chain.
+type ConfigChain struct {
+	Config                     ibc.ChainConfig
+	KeyName, RPCAddr, GRPCAddr string
+}
+
+// ConfigPath holds the arguments of GeneratePath for a path, and the settings of ConfigurePath, if any.
+type ConfigPath struct {
+	Name                   string
+	SrcChainID, DstChainID string
+	Config                 ibc.RelayerPathConfig
+}
+
+// ConfigFile is a config file of a relayer.
+type ConfigFile struct {
+	// Path of the file, relative to the home directory of the relayer.
+	Path string
+
+	Content []byte
+}
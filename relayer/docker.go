@@ -13,6 +13,7 @@ import (
 	volumetypes "github.com/docker/docker/api/types/volume"
 	"github.com/docker/docker/client"
 	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/docker/go-connections/nat"
 	"go.uber.org/zap"
 
 	"github.com/strangelove-ventures/interchaintest/v8/dockerutil"
@@ -44,12 +45,32 @@ type DockerRelayer struct {
 	// The ID of the container created by StartRelayer.
 	containerLifecycle *dockerutil.ContainerLifecycle
 
+	// Host address of the metrics server of the container created by StartRelayer,
+	// if the commander implements MetricsCommander.
+	metricsHostAddr string
+
+	// Host address of the API server of the container created by StartRelayer,
+	// if the commander implements APICommander.
+	apiHostAddr string
+
+	// Events parsed from the logs of the containers created by StartRelayer.
+	logEvents *RelayerLogEvents
+
+	// Stops following the logs of the container created by StartRelayer.
+	stopFollowingLogs func()
+
 	// wallets contains a mapping of chainID to relayer wallet
 	wallets map[string]ibc.Wallet
 
+	// pathConfigs contains the configs applied with ConfigurePath, by path name.
+	pathConfigs map[string]ibc.RelayerPathConfig
+
 	homeDir string
 
 	extraStartupFlags []string
+
+	// Whether the PacketMetrics option was given.
+	packetMetrics bool
 }
 
 var _ ibc.Relayer = (*DockerRelayer)(nil)
@@ -70,6 +91,8 @@ func NewDockerRelayer(ctx context.Context, log *zap.Logger, testName string, cli
 		testName: testName,
 
 		wallets: map[string]ibc.Wallet{},
+
+		logEvents: NewRelayerLogEvents(c),
 	}
 
 	r.homeDir = defaultRlyHomeDirectory
@@ -293,6 +316,10 @@ func (r *DockerRelayer) GetClients(ctx context.Context, rep ibc.RelayerExecRepor
 	return r.c.ParseGetClientsOutput(string(res.Stdout), string(res.Stderr))
 }
 
+func (r *DockerRelayer) GetClientStatus(ctx context.Context, rep ibc.RelayerExecReporter, pathName, chainID string) (ibc.ClientStatusOutput, error) {
+	return clientStatus(ctx, r, r.c, rep, pathName, chainID)
+}
+
 func (r *DockerRelayer) LinkPath(ctx context.Context, rep ibc.RelayerExecReporter, pathName string, channelOpts ibc.CreateChannelOptions, clientOpts ibc.CreateClientOptions) error {
 	cmd := r.c.LinkPath(pathName, r.HomeDir(), channelOpts, clientOpts)
 	res := r.Exec(ctx, rep, cmd, nil)
@@ -349,6 +376,19 @@ func (r *DockerRelayer) RestoreKey(ctx context.Context, rep ibc.RelayerExecRepor
 	return nil
 }
 
+// ConfigurePath applies cfg to the config file of the relayer, for commanders implementing PathConfigCommander.
+// The settings which the config file cannot hold are passed to StartRelayer.
+func (r *DockerRelayer) ConfigurePath(ctx context.Context, rep ibc.RelayerExecReporter, pathName string, cfg ibc.RelayerPathConfig) error {
+	if err := configurePath(ctx, r.c, r, pathName, cfg); err != nil {
+		return err
+	}
+	if r.pathConfigs == nil {
+		r.pathConfigs = make(map[string]ibc.RelayerPathConfig)
+	}
+	r.pathConfigs[pathName] = cfg
+	return nil
+}
+
 func (r *DockerRelayer) UpdateClients(ctx context.Context, rep ibc.RelayerExecReporter, pathName string) error {
 	cmd := r.c.UpdateClients(pathName, r.HomeDir())
 	res := r.Exec(ctx, rep, cmd, nil)
@@ -365,23 +405,165 @@ func (r *DockerRelayer) StartRelayer(ctx context.Context, rep ibc.RelayerExecRep
 	containerName := fmt.Sprintf("%s-%s-%s", r.c.Name(), joinedPaths, dockerutil.RandLowerCaseLetterString(5))
 
 	cmd := r.c.StartRelayer(r.HomeDir(), pathNames...)
+	startFlags, err := pathStartFlags(r.c, r.pathConfigs, pathNames)
+	if err != nil {
+		return err
+	}
+	cmd = append(cmd, startFlags...)
 
 	r.containerLifecycle = dockerutil.NewContainerLifecycle(r.log, r.client, containerName)
 
+	// Publish the metrics and API ports on random host ports, so that tests can reach the relayer.
+	ports := nat.PortMap{}
+	mc, hasMetrics := r.c.(MetricsCommander)
+	if hasMetrics {
+		if err := configureMetrics(ctx, r.c, r); err != nil {
+			return err
+		}
+		ports[nat.Port(mc.MetricsPort()+"/tcp")] = []nat.PortBinding{}
+	}
+	ac, hasAPI := r.c.(APICommander)
+	if hasAPI {
+		ports[nat.Port(ac.APIPort()+"/tcp")] = []nat.PortBinding{}
+	}
+
 	if err := r.containerLifecycle.CreateContainer(
-		ctx, r.testName, r.networkID, containerImage, nil,
+		ctx, r.testName, r.networkID, containerImage, ports,
 		r.Bind(), nil, r.HostName(joinedPaths), cmd, nil,
 	); err != nil {
 		return err
 	}
 
-	return r.containerLifecycle.StartContainer(ctx)
+	if err := r.containerLifecycle.StartContainer(ctx); err != nil {
+		return err
+	}
+
+	r.followLogs(containerName)
+
+	if hasMetrics {
+		hostPorts, err := r.containerLifecycle.GetHostPorts(ctx, mc.MetricsPort()+"/tcp")
+		if err != nil {
+			return err
+		}
+		r.metricsHostAddr = hostPorts[0]
+	}
+	if hasAPI {
+		hostPorts, err := r.containerLifecycle.GetHostPorts(ctx, ac.APIPort()+"/tcp")
+		if err != nil {
+			return err
+		}
+		r.apiHostAddr = hostPorts[0]
+	}
+
+	return nil
+}
+
+// APIAddress returns the base URL of the API server of the relayer started with StartRelayer,
+// through the port published on the host.
+func (r *DockerRelayer) APIAddress() (string, error) {
+	if _, ok := r.c.(APICommander); !ok {
+		return "", fmt.Errorf("%s does not serve an API", r.c.Name())
+	}
+	if r.containerLifecycle == nil || r.apiHostAddr == "" {
+		return "", fmt.Errorf("relayer not started")
+	}
+	return "http://" + r.apiHostAddr, nil
+}
+
+// PacketMetricsEnabled reports whether the relayer was built with the PacketMetrics option.
+func (r *DockerRelayer) PacketMetricsEnabled() bool {
+	return r.packetMetrics
+}
+
+// Metrics returns a RelayerMetrics scraping the Prometheus metrics of the relayer started with StartRelayer,
+// through the port published on the host.
+// Other containers on the test network can reach the metrics at MetricsNetworkAddress.
+func (r *DockerRelayer) Metrics() (*RelayerMetrics, error) {
+	mc, ok := r.c.(MetricsCommander)
+	if !ok {
+		return nil, fmt.Errorf("%s does not expose metrics", r.c.Name())
+	}
+	if r.containerLifecycle == nil || r.metricsHostAddr == "" {
+		return nil, fmt.Errorf("relayer not started")
+	}
+	return NewRelayerMetrics(r.log, "http://"+r.metricsHostAddr+mc.MetricsPath(), mc.MetricNames()), nil
+}
+
+// MetricsNetworkAddress returns the address of the metrics server on the docker network of the test,
+// for relayers started with pathNames whose commander implements MetricsCommander.
+func (r *DockerRelayer) MetricsNetworkAddress(pathNames ...string) (string, bool) {
+	mc, ok := r.c.(MetricsCommander)
+	if !ok {
+		return "", false
+	}
+	return r.HostName(strings.Join(pathNames, ".")) + ":" + mc.MetricsPort(), true
+}
+
+// followLogs streams the logs of the container created by StartRelayer into the logger,
+// and parses them into the events returned by LogEvents, until the container stops.
+func (r *DockerRelayer) followLogs(containerName string) {
+	// The stream must outlive the context of StartRelayer, like the container does.
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	containerID := r.containerLifecycle.ContainerID()
+
+	go func() {
+		defer close(done)
+
+		rc, err := r.client.ContainerLogs(ctx, containerID, types.ContainerLogsOptions{
+			ShowStdout: true,
+			ShowStderr: true,
+			Follow:     true,
+		})
+		if err != nil {
+			r.log.Info("Failed to follow relayer logs", zap.String("container", containerName), zap.Error(err))
+			return
+		}
+		defer func() { _ = rc.Close() }()
+
+		log := r.log.With(zap.String("container", containerName))
+		stdout := r.logEvents.Writer(log.With(zap.String("stream", "stdout")))
+		stderr := r.logEvents.Writer(log.With(zap.String("stream", "stderr")))
+		defer func() {
+			_ = stdout.Close()
+			_ = stderr.Close()
+		}()
+
+		// Logs are multiplexed into one stream; see docs for ContainerLogs.
+		_, _ = stdcopy.StdCopy(stdout, stderr, rc)
+	}()
+
+	r.stopFollowingLogs = func() {
+		// Give the stream a moment to deliver the last lines of a stopped container.
+		select {
+		case <-done:
+		case <-time.After(followLogsStopTimeout):
+		}
+		cancel()
+		<-done
+	}
+}
+
+// followLogsStopTimeout is how long stopping to follow logs waits for the remaining logs to be streamed.
+const followLogsStopTimeout = 5 * time.Second
+
+// LogEvents returns the events parsed from the logs of the relayer,
+// every time it was started with StartRelayer.
+func (r *DockerRelayer) LogEvents() *RelayerLogEvents {
+	return r.logEvents
 }
 
 func (r *DockerRelayer) StopRelayer(ctx context.Context, rep ibc.RelayerExecReporter) error {
 	if r.containerLifecycle == nil {
 		return nil
 	}
+	// Stop following the logs even if the container fails to stop, so the stream is not leaked.
+	if r.stopFollowingLogs != nil {
+		defer func() {
+			r.stopFollowingLogs()
+			r.stopFollowingLogs = nil
+		}()
+	}
 	if err := r.containerLifecycle.StopContainer(ctx); err != nil {
 		return err
 	}
@@ -447,6 +629,8 @@ func (r *DockerRelayer) StopRelayer(ctx context.Context, rep ibc.RelayerExecRepo
 	}
 
 	r.containerLifecycle = nil
+	r.metricsHostAddr = ""
+	r.apiHostAddr = ""
 
 	return nil
 }
@@ -513,8 +697,14 @@ func (r *DockerRelayer) UseDockerNetwork() bool {
 	return true
 }
 
+// UpgradeChannel is not supported by the generic docker relayer.
+// Implementations which can drive the channel upgrade handshake must override it.
+func (r *DockerRelayer) UpgradeChannel(ctx context.Context, rep ibc.RelayerExecReporter, pathName, channelID string) error {
+	return fmt.Errorf("%s does not support channel upgrades", r.c.Name())
+}
+
 func (r *DockerRelayer) SetClientContractHash(ctx context.Context, rep ibc.RelayerExecReporter, cfg ibc.ChainConfig, hash string) error {
-	panic("[rly/SetClientContractHash] Implement me")
+	return fmt.Errorf("%s does not support wasm light clients", r.c.Name())
 }
 
 type RelayerCommander interface {
@@ -550,6 +740,10 @@ type RelayerCommander interface {
 	// to produce the client output values.
 	ParseGetClientsOutput(stdout, stderr string) (ibc.ClientOutputs, error)
 
+	// ParseGetClientStatusOutput processes the output of GetClientStatus
+	// to produce the status of the client hosted on chainID.
+	ParseGetClientStatusOutput(chainID, stdout, stderr string) (ibc.ClientStatusOutput, error)
+
 	// Init is the command to run on the first call to AddChainConfiguration.
 	// If the returned command is nil or empty, nothing will be executed.
 	Init(homeDir string) []string
@@ -568,6 +762,7 @@ type RelayerCommander interface {
 	GetChannels(chainID, homeDir string) []string
 	GetConnections(chainID, homeDir string) []string
 	GetClients(chainID, homeDir string) []string
+	GetClientStatus(pathName, chainID, homeDir string) []string
 	LinkPath(pathName, homeDir string, channelOpts ibc.CreateChannelOptions, clientOpts ibc.CreateClientOptions) []string
 	RestoreKey(chainID, keyName, coinType, signingAlgorithm, mnemonic, homeDir string) []string
 	StartRelayer(homeDir string, pathNames ...string) []string
@@ -0,0 +1,40 @@
+package hermes
+
+import (
+	"fmt"
+
+	"github.com/pelletier/go-toml"
+	"github.com/strangelove-ventures/interchaintest/v8/relayer"
+)
+
+// NewConfigRenderer returns a relayer.ConfigRenderer for hermes.
+func NewConfigRenderer() relayer.ConfigRenderer {
+	return commander{}
+}
+
+// RenderConfig renders the hermes config file, with the settings of the paths applied.
+// Paths themselves only exist in interchaintest, not in the config of hermes.
+func (c commander) RenderConfig(chains []relayer.ConfigChain, paths []relayer.ConfigPath) ([]relayer.ConfigFile, error) {
+	chainConfigs := make([]ChainConfig, 0, len(chains))
+	for _, chain := range chains {
+		chainConfigs = append(chainConfigs, ChainConfig{
+			cfg:      chain.Config,
+			keyName:  chain.KeyName,
+			rpcAddr:  chain.RPCAddr,
+			grpcAddr: chain.GRPCAddr,
+		})
+	}
+
+	config := NewConfig(chainConfigs...)
+	for _, path := range paths {
+		if err := config.ApplyPathConfig(path.SrcChainID, path.DstChainID, path.Config); err != nil {
+			return nil, fmt.Errorf("configuring path %s: %w", path.Name, err)
+		}
+	}
+
+	content, err := toml.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	return []relayer.ConfigFile{{Path: hermesConfigPath, Content: content}}, nil
+}
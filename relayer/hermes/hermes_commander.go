@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/strangelove-ventures/interchaintest/v8/ibc"
 	"github.com/strangelove-ventures/interchaintest/v8/relayer"
@@ -13,7 +14,20 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
 )
 
-var _ relayer.RelayerCommander = &commander{}
+var (
+	_ relayer.RelayerCommander = &commander{}
+	_ relayer.MetricsCommander = &commander{}
+	_ relayer.LogCommander     = &commander{}
+	_ relayer.APICommander     = &commander{}
+)
+
+const (
+	// telemetryPort is the port of the telemetry server enabled in the generated config.
+	telemetryPort = 3001
+
+	// restPort is the port of the REST server enabled in the generated config.
+	restPort = 3000
+)
 
 type commander struct {
 	log             *zap.Logger
@@ -36,8 +50,32 @@ func (c commander) DockerUser() string {
 	return hermesDefaultUidGid
 }
 
+func (c commander) MetricsPort() string {
+	return strconv.Itoa(telemetryPort)
+}
+
+func (c commander) MetricsPath() string {
+	return "/metrics"
+}
+
+func (c commander) APIPort() string {
+	return strconv.Itoa(restPort)
+}
+
+func (c commander) MetricNames() relayer.MetricNames {
+	return relayer.MetricNames{
+		PacketsRelayed: "receive_packets_confirmed_total",
+		TxFailures:     "broadcast_errors_total",
+		ClientUpdates:  "client_updates_submitted_total",
+		WalletBalance:  "wallet_balance",
+	}
+}
+
 func (c commander) ParseGetChannelsOutput(stdout, stderr string) ([]ibc.ChannelOutput, error) {
-	jsonBz := extractJsonResult([]byte(stdout))
+	jsonBz, err := extractJsonResult([]byte(stdout))
+	if err != nil {
+		return nil, err
+	}
 	var result ChannelOutputResult
 	if err := json.Unmarshal(jsonBz, &result); err != nil {
 		return nil, err
@@ -63,7 +101,10 @@ func (c commander) ParseGetChannelsOutput(stdout, stderr string) ([]ibc.ChannelO
 }
 
 func (c commander) ParseGetConnectionsOutput(stdout, stderr string) (ibc.ConnectionOutputs, error) {
-	jsonBz := extractJsonResult([]byte(stdout))
+	jsonBz, err := extractJsonResult([]byte(stdout))
+	if err != nil {
+		return ibc.ConnectionOutputs{}, err
+	}
 	var queryResult ConnectionQueryResult
 	if err := json.Unmarshal(jsonBz, &queryResult); err != nil {
 		return ibc.ConnectionOutputs{}, err
@@ -98,7 +139,10 @@ func (c commander) ParseGetConnectionsOutput(stdout, stderr string) (ibc.Connect
 }
 
 func (c commander) ParseGetClientsOutput(stdout, stderr string) (ibc.ClientOutputs, error) {
-	jsonBz := extractJsonResult([]byte(stdout))
+	jsonBz, err := extractJsonResult([]byte(stdout))
+	if err != nil {
+		return ibc.ClientOutputs{}, err
+	}
 	var queryResult ClientQueryResult
 	if err := json.Unmarshal(jsonBz, &queryResult); err != nil {
 		return ibc.ClientOutputs{}, err
@@ -153,6 +197,14 @@ func (c commander) UpdatePath(pathName, homeDir string, filter ibc.ChannelFilter
 // the following methods do not have a single command that cleanly maps to a single hermes command without
 // additional logic wrapping them. They have been implemented one layer up in the hermes relayer.
 
+func (c commander) GetClientStatus(pathName, chainID, homeDir string) []string {
+	panic("get client status implemented in hermes relayer not the commander")
+}
+
+func (c commander) ParseGetClientStatusOutput(chainID, stdout, stderr string) (ibc.ClientStatusOutput, error) {
+	panic("get client status implemented in hermes relayer not the commander")
+}
+
 func (c commander) UpdateClients(pathName, homeDir string) []string {
 	panic("update clients implemented in hermes relayer not the commander")
 }
@@ -4,14 +4,21 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/testutil"
 )
 
 // NewConfig returns a hermes Config with an entry for each of the provided ChainConfigs.
 // The defaults were adapted from the sample config file found here: https://github.com/informalsystems/hermes/blob/master/config.toml
 func NewConfig(chainConfigs ...ChainConfig) Config {
 	var chains []Chain
+	blockTimes := make(map[string]time.Duration, len(chainConfigs))
 	for _, hermesCfg := range chainConfigs {
 		chainCfg := hermesCfg.cfg
+		blockTimes[chainCfg.ChainID] = chainBlockTime(chainCfg)
 
 		gasPricesStr, err := strconv.ParseFloat(strings.ReplaceAll(chainCfg.GasPrices, chainCfg.Denom, ""), 32)
 		if err != nil {
@@ -80,16 +87,103 @@ func NewConfig(chainConfigs ...ChainConfig) Config {
 			},
 		},
 		Rest: Rest{
-			Enabled: false,
+			Enabled: true,
+			Host:    "0.0.0.0",
+			Port:    restPort,
 		},
 		Telemetry: Telemetry{
-			Enabled: false,
+			Enabled: true,
+			Host:    "0.0.0.0",
+			Port:    telemetryPort,
 		},
 		TracingServer: TracingServer{
 			Enabled: false,
 		},
-		Chains: chains,
+		Chains:     chains,
+		blockTimes: blockTimes,
+	}
+}
+
+// defaultBlockTime is the timeout_commit of the cosmos chains started by interchaintest.
+const defaultBlockTime = 2 * time.Second
+
+// chainBlockTime returns the block time of the chain: the block time of CometMock if it uses it,
+// otherwise the timeout_commit of its config.toml overrides, or defaultBlockTime.
+func chainBlockTime(cfg ibc.ChainConfig) time.Duration {
+	if cfg.UsesCometMock() && cfg.CometMock.BlockTimeMs > 0 {
+		return time.Duration(cfg.CometMock.BlockTimeMs) * time.Millisecond
+	}
+	config, _ := cfg.ConfigFileOverrides["config/config.toml"].(testutil.Toml)
+	consensus, _ := config["consensus"].(testutil.Toml)
+	if timeoutCommit, ok := consensus["timeout_commit"].(string); ok {
+		if d, err := time.ParseDuration(timeoutCommit); err == nil && d > 0 {
+			return d
+		}
+	}
+	return defaultBlockTime
+}
+
+// ApplyPathConfig applies cfg to the path between chainA and chainB.
+// Hermes has no notion of paths: the channel filter applies to every path of chainA, the batch size,
+// memo and gas settings to every path of the chains, and the clear interval to every path.
+func (c *Config) ApplyPathConfig(chainA, chainB string, cfg ibc.RelayerPathConfig) error {
+	if err := cfg.Validate(chainA, chainB); err != nil {
+		return err
+	}
+
+	if cfg.ClearInterval > 0 {
+		// Hermes counts the clear interval in blocks, so convert it with the block time of the slower chain.
+		blockTime := max(c.blockTimes[chainA], c.blockTimes[chainB])
+		if blockTime == 0 {
+			blockTime = defaultBlockTime
+		}
+		c.Mode.Packets.ClearInterval = max(1, int(cfg.ClearInterval/blockTime))
 	}
+
+	for i := range c.Chains {
+		chain := &c.Chains[i]
+		if chain.ID != chainA && chain.ID != chainB {
+			continue
+		}
+
+		if chain.ID == chainA && cfg.Filter.Rule != "" {
+			policy := "allow"
+			if cfg.Filter.Rule == ibc.ChannelFilterDenylist {
+				policy = "deny"
+			}
+			list := [][]string{}
+			for _, channelID := range cfg.Filter.ChannelList {
+				list = append(list, []string{"*", channelID})
+			}
+			chain.PacketFilter = &PacketFilter{Policy: policy, List: list}
+		}
+		if cfg.MaxMsgsPerTx > 0 {
+			chain.MaxMsgNum = cfg.MaxMsgsPerTx
+		}
+		if cfg.Memo != "" {
+			chain.MemoPrefix = cfg.Memo
+		}
+
+		gas, ok := cfg.ChainGas[chain.ID]
+		if !ok {
+			continue
+		}
+		if gas.GasPrices != "" {
+			price, err := sdk.ParseDecCoin(gas.GasPrices)
+			if err != nil {
+				return err
+			}
+			chain.GasPrice = GasPrice{Price: price.Amount.MustFloat64(), Denom: price.Denom}
+		}
+		if gas.GasAdjustment != 0 {
+			// Hermes multiplies the simulated gas by gas_multiplier, like the gas adjustment of the SDK.
+			chain.GasMultiplier = gas.GasAdjustment
+		}
+		if gas.MaxGas != 0 {
+			chain.MaxGas = int(gas.MaxGas)
+		}
+	}
+	return nil
 }
 
 type Config struct {
@@ -99,6 +193,9 @@ type Config struct {
 	Telemetry     Telemetry     `toml:"telemetry"`
 	TracingServer TracingServer `toml:"tracing_server"`
 	Chains        []Chain       `toml:"chains"`
+
+	// Block time of each chain, by chain ID, to convert durations into the number of blocks hermes counts.
+	blockTimes map[string]time.Duration
 }
 
 type Global struct {
@@ -193,4 +290,11 @@ type Chain struct {
 	TrustingPeriod   string         `toml:"trusting_period"`
 	TrustThreshold   TrustThreshold `toml:"trust_threshold"`
 	MemoPrefix       string         `toml:"memo_prefix,omitempty"`
+	PacketFilter     *PacketFilter  `toml:"packet_filter,omitempty"`
+}
+
+// PacketFilter restricts the channels hermes relays packets on, with a list of port and channel ID pairs.
+type PacketFilter struct {
+	Policy string     `toml:"policy"`
+	List   [][]string `toml:"list"`
 }
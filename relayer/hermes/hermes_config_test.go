@@ -0,0 +1,78 @@
+package hermes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pelletier/go-toml"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_ApplyPathConfig(t *testing.T) {
+	cfg := NewConfig(
+		ChainConfig{cfg: ibc.ChainConfig{ChainID: "ibc-0", Denom: "uatom", GasPrices: "0.01uatom", GasAdjustment: 1.3}},
+		ChainConfig{cfg: ibc.ChainConfig{ChainID: "ibc-1", Denom: "stake", GasPrices: "0.01stake", GasAdjustment: 1.3}},
+		ChainConfig{cfg: ibc.ChainConfig{ChainID: "ibc-2", Denom: "stake", GasPrices: "0.01stake", GasAdjustment: 1.3}},
+	)
+
+	require.NoError(t, cfg.ApplyPathConfig("ibc-0", "ibc-1", ibc.RelayerPathConfig{
+		Filter:        ibc.ChannelFilter{Rule: ibc.ChannelFilterDenylist, ChannelList: []string{"channel-1"}},
+		ClearInterval: time.Minute,
+		MaxMsgsPerTx:  5,
+		Memo:          "tuned",
+		ChainGas: map[string]ibc.ChainGasConfig{
+			"ibc-1": {GasPrices: "0.25stake", GasAdjustment: 1.5, MaxGas: 1_000_000},
+		},
+	}))
+
+	require.Equal(t, 30, cfg.Mode.Packets.ClearInterval)
+
+	a, b, other := cfg.Chains[0], cfg.Chains[1], cfg.Chains[2]
+	require.Equal(t, &PacketFilter{Policy: "deny", List: [][]string{{"*", "channel-1"}}}, a.PacketFilter)
+	require.Nil(t, b.PacketFilter, "the filter applies to the source chain only")
+	require.Equal(t, 5, a.MaxMsgNum)
+	require.Equal(t, "tuned", b.MemoPrefix)
+	require.Equal(t, GasPrice{Price: 0.25, Denom: "stake"}, b.GasPrice)
+	require.Equal(t, 1.5, b.GasMultiplier)
+	require.Equal(t, 1_000_000, b.MaxGas)
+	require.Equal(t, GasPrice{Price: 0.01, Denom: "uatom"}.Denom, a.GasPrice.Denom)
+	require.Equal(t, 30, other.MaxMsgNum, "chains outside the path keep their settings")
+
+	bz, err := toml.Marshal(cfg)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `policy = "deny"`)
+
+	require.Error(t, cfg.ApplyPathConfig("ibc-0", "ibc-1", ibc.RelayerPathConfig{
+		ChainGas: map[string]ibc.ChainGasConfig{"ibc-2": {MaxGas: 1}},
+	}))
+}
+
+func TestConfig_ApplyPathConfig_ClearIntervalBlockTime(t *testing.T) {
+	slow := ibc.ChainConfig{ChainID: "ibc-1", Denom: "stake", GasPrices: "0.01stake", ConfigFileOverrides: map[string]any{
+		"config/config.toml": testutil.Toml{"consensus": testutil.Toml{"timeout_commit": "5s"}},
+	}}
+	mock := ibc.ChainConfig{ChainID: "ibc-2", Denom: "stake", GasPrices: "0.01stake", CometMock: ibc.CometMockConfig{
+		Image:       ibc.DockerImage{Repository: "cometmock", Version: "v1"},
+		BlockTimeMs: 500,
+	}}
+	def := ibc.ChainConfig{ChainID: "ibc-0", Denom: "uatom", GasPrices: "0.01uatom"}
+
+	for _, tt := range []struct {
+		Name           string
+		ChainA, ChainB ibc.ChainConfig
+		Interval       time.Duration
+		Want           int
+	}{
+		{"default block time", def, def, time.Minute, 30},
+		{"slower chain", def, slow, time.Minute, 12},
+		{"cometmock", mock, mock, 10 * time.Second, 20},
+		{"rounded down", def, def, 5 * time.Second, 2},
+		{"at least a block", def, slow, time.Second, 1},
+	} {
+		cfg := NewConfig(ChainConfig{cfg: tt.ChainA}, ChainConfig{cfg: tt.ChainB})
+		require.NoError(t, cfg.ApplyPathConfig(tt.ChainA.ChainID, tt.ChainB.ChainID, ibc.RelayerPathConfig{ClearInterval: tt.Interval}), tt.Name)
+		require.Equal(t, tt.Want, cfg.Mode.Packets.ClearInterval, tt.Name)
+	}
+}
@@ -0,0 +1,91 @@
+package hermes
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/strangelove-ventures/interchaintest/v8/relayer"
+)
+
+var (
+	// hermesLogLineRe matches a line of the default text log format, e.g.
+	// "2024-05-01T12:00:00.123456Z  INFO ThreadId(24) packet_cmd{src_chain=ibc-0 dst_chain=ibc-1}: assembled batch of 2 message(s)".
+	hermesLogLineRe = regexp.MustCompile(`^(\S+)\s+(TRACE|DEBUG|INFO|WARN|ERROR)\s+(?:ThreadId\(\d+\)\s+)?(.*)$`)
+
+	hermesBatchRe = regexp.MustCompile(`assembled batch of (\d+) message\(s\)`)
+	hermesChainRe = regexp.MustCompile(`\b(?:dst_chain|chain)"?[=:]"?([^\s}",]+)`)
+)
+
+// ParseLogLine parses a line logged by hermes with the text or JSON log format.
+// The messages of a relayed packet batch include the client updates submitted with the packets.
+func (c commander) ParseLogLine(line string) (relayer.RelayerLogEvent, bool) {
+	ts, level, msg, ok := parseHermesLogLine(relayer.StripANSI(line))
+	if !ok {
+		return relayer.RelayerLogEvent{}, false
+	}
+
+	ev := relayer.RelayerLogEvent{
+		Message: msg,
+		Line:    line,
+	}
+	if t, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+		ev.Time = t
+	}
+	if m := hermesChainRe.FindStringSubmatch(msg); m != nil {
+		ev.ChainID = m[1]
+	}
+
+	retry := strings.Contains(strings.ToLower(msg), "retry")
+	switch {
+	case level == "INFO" && strings.Contains(msg, "packet"):
+		m := hermesBatchRe.FindStringSubmatch(msg)
+		if m == nil {
+			return relayer.RelayerLogEvent{}, false
+		}
+		ev.Kind = relayer.LogEventPacketsRelayed
+		ev.Messages, _ = strconv.Atoi(m[1])
+		return ev, true
+	case (level == "WARN" || level == "ERROR") && retry:
+		ev.Kind = relayer.LogEventRetry
+	case level == "ERROR":
+		ev.Kind = relayer.LogEventError
+	case level == "WARN" && relayer.ClassifyError(msg) != relayer.ErrorClassOther:
+		ev.Kind = relayer.LogEventError
+	default:
+		return relayer.RelayerLogEvent{}, false
+	}
+	ev.ErrorClass = relayer.ClassifyError(msg)
+	return ev, true
+}
+
+// parseHermesLogLine returns the timestamp, level and message, including the span, of a hermes log line.
+func parseHermesLogLine(line string) (ts, level, msg string, ok bool) {
+	if strings.HasPrefix(line, "{") {
+		var l struct {
+			Timestamp string `json:"timestamp"`
+			Level     string `json:"level"`
+			Fields    struct {
+				Message string `json:"message"`
+			} `json:"fields"`
+			Span map[string]any `json:"span"`
+		}
+		if err := json.Unmarshal([]byte(line), &l); err != nil {
+			return "", "", "", false
+		}
+		msg = l.Fields.Message
+		if len(l.Span) > 0 {
+			span, _ := json.Marshal(l.Span)
+			msg = string(span) + ": " + msg
+		}
+		return l.Timestamp, l.Level, msg, true
+	}
+
+	m := hermesLogLineRe.FindStringSubmatch(line)
+	if m == nil {
+		return "", "", "", false
+	}
+	return m[1], m[2], m[3], true
+}
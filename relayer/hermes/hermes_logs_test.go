@@ -0,0 +1,33 @@
+package hermes
+
+import (
+	"testing"
+
+	"github.com/strangelove-ventures/interchaintest/v8/relayer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommander_ParseLogLine(t *testing.T) {
+	var c commander
+
+	ev, ok := c.ParseLogLine("2024-05-01T12:00:00.123456Z  INFO ThreadId(24) packet_cmd{src_chain=ibc-0 src_port=transfer src_channel=channel-0 dst_chain=ibc-1}: assembled batch of 3 message(s)")
+	require.True(t, ok)
+	require.Equal(t, relayer.LogEventPacketsRelayed, ev.Kind)
+	require.Equal(t, 3, ev.Messages)
+	require.Equal(t, "ibc-1", ev.ChainID)
+
+	ev, ok = c.ParseLogLine("\x1b[2m2024-05-01T12:00:01.000000Z\x1b[0m \x1b[33m WARN\x1b[0m ThreadId(12) send_tx_with_account_sequence_retry{chain=ibc-0 account.sequence=7}: failed to broadcast tx because of a mismatched account sequence number, refreshing account sequence number and retrying once: account sequence mismatch, expected 8, got 7")
+	require.True(t, ok)
+	require.Equal(t, relayer.LogEventRetry, ev.Kind)
+	require.Equal(t, relayer.ErrorClassSequenceMismatch, ev.ErrorClass)
+	require.Equal(t, "ibc-0", ev.ChainID)
+
+	ev, ok = c.ParseLogLine(`{"timestamp":"2024-05-01T12:00:02.000000Z","level":"ERROR","fields":{"message":"failed to update client: client state is not active: Expired"},"target":"ibc_relayer::foreign_client","span":{"dst_chain":"ibc-1","name":"foreign_client.update"}}`)
+	require.True(t, ok)
+	require.Equal(t, relayer.LogEventError, ev.Kind)
+	require.Equal(t, relayer.ErrorClassClientExpired, ev.ErrorClass)
+	require.Equal(t, "ibc-1", ev.ChainID)
+
+	_, ok = c.ParseLogLine("2024-05-01T12:00:03.000000Z  INFO ThreadId(01) Hermes has started")
+	require.False(t, ok)
+}
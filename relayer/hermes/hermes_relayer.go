@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -26,7 +27,8 @@ const (
 )
 
 var (
-	_ ibc.Relayer = &Relayer{}
+	_ ibc.Relayer            = &Relayer{}
+	_ relayer.PathConfigurer = &Relayer{}
 	// parseRestoreKeyOutputPattern extracts the address from the hermes output.
 	// SUCCESS Restored key 'g2-2' (cosmos1czklnpzwaq3hfxtv6ne4vas2p9m5q3p3fgkz8e) on chain g2-2
 	parseRestoreKeyOutputPattern = regexp.MustCompile(`\((.*)\)`)
@@ -37,6 +39,9 @@ type Relayer struct {
 	*relayer.DockerRelayer
 	paths        map[string]*pathConfiguration
 	chainConfigs []ChainConfig
+
+	// pathSettings contains the configs applied with ConfigurePath, by path name.
+	pathSettings map[string]ibc.RelayerPathConfig
 }
 
 // ChainConfig holds all values required to write an entry in the "chains" section in the hermes config file.
@@ -75,6 +80,11 @@ func NewHermesRelayer(log *zap.Logger, testName string, cli *client.Client, netw
 	}
 }
 
+// Capabilities returns the set of capabilities of the hermes relayer.
+func Capabilities() map[relayer.Capability]bool {
+	return relayer.FullCapabilities()
+}
+
 // AddChainConfiguration is called once per chain configuration, which means that in the case of hermes, the single
 // config file is overwritten with a new entry each time this function is called.
 func (r *Relayer) AddChainConfiguration(ctx context.Context, rep ibc.RelayerExecReporter, chainConfig ibc.ChainConfig, keyName, rpcAddr, grpcAddr string) error {
@@ -268,6 +278,115 @@ func (r *Relayer) Flush(ctx context.Context, rep ibc.RelayerExecReporter, pathNa
 	return res.Err
 }
 
+// GetClientStatus queries the status of the client on chainID that was created for the path.
+func (r *Relayer) GetClientStatus(ctx context.Context, rep ibc.RelayerExecReporter, pathName, chainID string) (ibc.ClientStatusOutput, error) {
+	pathConfig, ok := r.paths[pathName]
+	if !ok {
+		return ibc.ClientStatusOutput{}, fmt.Errorf("path %s not found", pathName)
+	}
+
+	var clientID string
+	switch chainID {
+	case pathConfig.chainA.chainID:
+		clientID = pathConfig.chainA.clientID
+	case pathConfig.chainB.chainID:
+		clientID = pathConfig.chainB.clientID
+	default:
+		return ibc.ClientStatusOutput{}, fmt.Errorf("%s not found in path config", chainID)
+	}
+
+	cmd := []string{hermes, "--json", "query", "client", "status", "--chain", chainID, "--client", clientID}
+	res := r.Exec(ctx, rep, cmd, nil)
+	if res.Err != nil {
+		return ibc.ClientStatusOutput{}, res.Err
+	}
+
+	status, err := GetClientStatusFromStdout(res.Stdout)
+	if err != nil {
+		return ibc.ClientStatusOutput{}, err
+	}
+	return ibc.ClientStatusOutput{ClientID: clientID, ChainID: chainID, Status: status}, nil
+}
+
+// UpgradeChannel steps through the try, ack, confirm and open phases of the channel upgrade handshake.
+// The upgrade must already have been initiated for channelID on chain A of the path.
+func (r *Relayer) UpgradeChannel(ctx context.Context, rep ibc.RelayerExecReporter, pathName, channelID string) error {
+	pathConfig, ok := r.paths[pathName]
+	if !ok {
+		return fmt.Errorf("path %s not found", pathName)
+	}
+
+	chainAChannel, err := r.findChannel(ctx, rep, pathConfig.chainA.chainID, channelID)
+	if err != nil {
+		return err
+	}
+	chainBChannel, err := r.findChannel(ctx, rep, pathConfig.chainB.chainID, chainAChannel.Counterparty.ChannelID)
+	if err != nil {
+		return err
+	}
+
+	for _, cmd := range channelUpgradeCommands(pathConfig.chainA.chainID, pathConfig.chainB.chainID, chainAChannel, chainBChannel) {
+		if res := r.Exec(ctx, rep, cmd, nil); res.Err != nil {
+			return fmt.Errorf("failed to execute %s: %w", cmd[3], res.Err)
+		}
+	}
+	return nil
+}
+
+// channelUpgradeCommands returns the hermes commands of the try, ack, confirm and open phases
+// of the upgrade of chainAChannel, whose counterparty is chainBChannel.
+func channelUpgradeCommands(chainAID, chainBID string, chainAChannel, chainBChannel ibc.ChannelOutput) [][]string {
+	// Each step is submitted to the chain that was not the destination of the previous one,
+	// so the flags for chain A and chain B alternate between the src and dst roles.
+	towardsB := channelUpgradeArgs(chainBID, chainAID, chainBChannel, chainAChannel)
+	towardsA := channelUpgradeArgs(chainAID, chainBID, chainAChannel, chainBChannel)
+
+	steps := []struct {
+		subcommand string
+		args       []string
+	}{
+		{"chan-upgrade-try", towardsB},
+		{"chan-upgrade-ack", towardsA},
+		{"chan-upgrade-confirm", towardsB},
+		{"chan-upgrade-open", towardsA},
+	}
+	cmds := make([][]string, 0, len(steps))
+	for _, step := range steps {
+		cmds = append(cmds, append([]string{hermes, "--json", "tx", step.subcommand}, step.args...))
+	}
+	return cmds
+}
+
+// findChannel returns the channel with the given ID on chainID.
+func (r *Relayer) findChannel(ctx context.Context, rep ibc.RelayerExecReporter, chainID, channelID string) (ibc.ChannelOutput, error) {
+	channels, err := r.GetChannels(ctx, rep, chainID)
+	if err != nil {
+		return ibc.ChannelOutput{}, err
+	}
+	for _, channel := range channels {
+		if channel.ChannelID == channelID {
+			return channel, nil
+		}
+	}
+	return ibc.ChannelOutput{}, fmt.Errorf("channel %s not found on chain %s", channelID, chainID)
+}
+
+// channelUpgradeArgs returns the flags shared by the hermes chan-upgrade-* transactions.
+func channelUpgradeArgs(dstChainID, srcChainID string, dst, src ibc.ChannelOutput) []string {
+	args := []string{
+		"--dst-chain", dstChainID,
+		"--src-chain", srcChainID,
+		"--dst-port", dst.PortID,
+		"--src-port", src.PortID,
+		"--dst-channel", dst.ChannelID,
+		"--src-channel", src.ChannelID,
+	}
+	if len(dst.ConnectionHops) > 0 {
+		args = append(args, "--dst-connection", dst.ConnectionHops[0])
+	}
+	return args
+}
+
 // GeneratePath establishes an in memory path representation. The concept does not exist in hermes, so it is handled
 // at the interchain test level.
 func (r *Relayer) GeneratePath(ctx context.Context, rep ibc.RelayerExecReporter, srcChainID, dstChainID, pathName string) error {
@@ -295,7 +414,27 @@ func (r *Relayer) configContent(cfg ibc.ChainConfig, keyName, rpcAddr, grpcAddr
 		rpcAddr:  rpcAddr,
 		grpcAddr: grpcAddr,
 	})
+	return r.marshalConfig()
+}
+
+// marshalConfig returns the contents of the hermes config file for the chains and path settings added so far.
+func (r *Relayer) marshalConfig() ([]byte, error) {
 	hermesConfig := NewConfig(r.chainConfigs...)
+	// The confirmed packet metrics need hermes to wait for tx confirmations.
+	hermesConfig.Mode.Packets.TxConfirmation = r.PacketMetricsEnabled()
+
+	pathNames := make([]string, 0, len(r.pathSettings))
+	for pathName := range r.pathSettings {
+		pathNames = append(pathNames, pathName)
+	}
+	sort.Strings(pathNames)
+	for _, pathName := range pathNames {
+		pathConfig := r.paths[pathName]
+		if err := hermesConfig.ApplyPathConfig(pathConfig.chainA.chainID, pathConfig.chainB.chainID, r.pathSettings[pathName]); err != nil {
+			return nil, fmt.Errorf("configuring path %s: %w", pathName, err)
+		}
+	}
+
 	bz, err := toml.Marshal(hermesConfig)
 	if err != nil {
 		return nil, err
@@ -303,6 +442,35 @@ func (r *Relayer) configContent(cfg ibc.ChainConfig, keyName, rpcAddr, grpcAddr
 	return bz, nil
 }
 
+// ConfigurePath applies cfg to the hermes config file. The settings are kept, so that they survive
+// the config file being regenerated when chains are added.
+// See (*Config).ApplyPathConfig for how the settings of a path apply to hermes.
+func (r *Relayer) ConfigurePath(ctx context.Context, rep ibc.RelayerExecReporter, pathName string, cfg ibc.RelayerPathConfig) error {
+	if _, ok := r.paths[pathName]; !ok {
+		return fmt.Errorf("path %s not found", pathName)
+	}
+
+	prev, hadPrev := r.pathSettings[pathName]
+	if r.pathSettings == nil {
+		r.pathSettings = make(map[string]ibc.RelayerPathConfig)
+	}
+	r.pathSettings[pathName] = cfg
+
+	configContent, err := r.marshalConfig()
+	if err != nil {
+		if hadPrev {
+			r.pathSettings[pathName] = prev
+		} else {
+			delete(r.pathSettings, pathName)
+		}
+		return err
+	}
+	if err := r.WriteFileToHomeDir(ctx, hermesConfigPath, configContent); err != nil {
+		return fmt.Errorf("failed to write hermes config: %w", err)
+	}
+	return r.validateConfig(ctx, rep)
+}
+
 // validateConfig validates the hermes config file. Any errors are propagated to the test.
 func (r *Relayer) validateConfig(ctx context.Context, rep ibc.RelayerExecReporter) error {
 	cmd := []string{hermes, "--config", fmt.Sprintf("%s/%s", r.HomeDir(), hermesConfigPath), "config", "validate"}
@@ -313,32 +481,61 @@ func (r *Relayer) validateConfig(ctx context.Context, rep ibc.RelayerExecReporte
 	return nil
 }
 
-// extractJsonResult extracts the json result for the hermes query.
-func extractJsonResult(stdout []byte) []byte {
-	stdoutLines := strings.Split(string(stdout), "\n")
-	var jsonOutput string
-	for _, line := range stdoutLines {
-		if strings.Contains(line, "result") {
-			jsonOutput = line
-			break
+// extractJsonResult extracts the json result for the hermes query, e.g. {"result":...,"status":"success"}.
+// With --json, hermes may print its logs as JSON objects too, so the result is the last line holding a status.
+func extractJsonResult(stdout []byte) ([]byte, error) {
+	lines := strings.Split(strings.TrimSpace(string(stdout)), "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		line := []byte(strings.TrimSpace(lines[i]))
+		var res struct {
+			Status string          `json:"status"`
+			Result json.RawMessage `json:"result"`
+		}
+		if err := json.Unmarshal(line, &res); err != nil || res.Status == "" {
+			continue
 		}
+		if res.Status != "success" {
+			return nil, fmt.Errorf("hermes query failed with status %s: %s", res.Status, res.Result)
+		}
+		return line, nil
 	}
-	return []byte(jsonOutput)
+	return nil, fmt.Errorf("no json result in hermes output")
 }
 
 // GetClientIdFromStdout extracts the client ID from stdout.
 func GetClientIdFromStdout(stdout []byte) (string, error) {
 	var clientCreationResult ClientCreationResponse
-	if err := json.Unmarshal(extractJsonResult(stdout), &clientCreationResult); err != nil {
+	jsonBz, err := extractJsonResult(stdout)
+	if err != nil {
+		return "", err
+	}
+	if err := json.Unmarshal(jsonBz, &clientCreationResult); err != nil {
 		return "", err
 	}
 	return clientCreationResult.Result.CreateClient.ClientID, nil
 }
 
+// GetClientStatusFromStdout extracts the client status from stdout.
+func GetClientStatusFromStdout(stdout []byte) (ibc.ClientStatus, error) {
+	var clientStatusResult ClientStatusResponse
+	jsonBz, err := extractJsonResult(stdout)
+	if err != nil {
+		return ibc.ClientStatusUnknown, err
+	}
+	if err := json.Unmarshal(jsonBz, &clientStatusResult); err != nil {
+		return ibc.ClientStatusUnknown, err
+	}
+	return ibc.ClientStatus(clientStatusResult.Result), nil
+}
+
 // GetConnectionIDsFromStdout extracts the connectionIDs on both ends from the stdout.
 func GetConnectionIDsFromStdout(stdout []byte) (string, string, error) {
 	var connectionResponse ConnectionResponse
-	if err := json.Unmarshal(extractJsonResult(stdout), &connectionResponse); err != nil {
+	jsonBz, err := extractJsonResult(stdout)
+	if err != nil {
+		return "", "", err
+	}
+	if err := json.Unmarshal(jsonBz, &connectionResponse); err != nil {
 		return "", "", err
 	}
 	return connectionResponse.Result.ASide.ConnectionID, connectionResponse.Result.BSide.ConnectionID, nil
@@ -347,7 +544,11 @@ func GetConnectionIDsFromStdout(stdout []byte) (string, string, error) {
 // GetChannelIDsFromStdout extracts the channelIDs on both ends from stdout.
 func GetChannelIDsFromStdout(stdout []byte) (string, string, error) {
 	var channelResponse ChannelCreationResponse
-	if err := json.Unmarshal(extractJsonResult(stdout), &channelResponse); err != nil {
+	jsonBz, err := extractJsonResult(stdout)
+	if err != nil {
+		return "", "", err
+	}
+	if err := json.Unmarshal(jsonBz, &channelResponse); err != nil {
 		return "", "", err
 	}
 	return channelResponse.Result.ASide.ChannelID, channelResponse.Result.BSide.ChannelID, nil
@@ -0,0 +1,51 @@
+package hermes
+
+import (
+	"testing"
+
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelUpgradeCommands(t *testing.T) {
+	chainA := ibc.ChannelOutput{PortID: "transfer", ChannelID: "channel-0", ConnectionHops: []string{"connection-0"}}
+	chainB := ibc.ChannelOutput{PortID: "transfer", ChannelID: "channel-3", ConnectionHops: []string{"connection-2"}}
+
+	towardsB := []string{
+		"--dst-chain", "ibc-1", "--src-chain", "ibc-0",
+		"--dst-port", "transfer", "--src-port", "transfer",
+		"--dst-channel", "channel-3", "--src-channel", "channel-0",
+		"--dst-connection", "connection-2",
+	}
+	towardsA := []string{
+		"--dst-chain", "ibc-0", "--src-chain", "ibc-1",
+		"--dst-port", "transfer", "--src-port", "transfer",
+		"--dst-channel", "channel-0", "--src-channel", "channel-3",
+		"--dst-connection", "connection-0",
+	}
+
+	cmds := channelUpgradeCommands("ibc-0", "ibc-1", chainA, chainB)
+	require.Len(t, cmds, 4)
+	for i, tt := range []struct {
+		Subcommand string
+		Args       []string
+	}{
+		{"chan-upgrade-try", towardsB},
+		{"chan-upgrade-ack", towardsA},
+		{"chan-upgrade-confirm", towardsB},
+		{"chan-upgrade-open", towardsA},
+	} {
+		want := append([]string{"hermes", "--json", "tx", tt.Subcommand}, tt.Args...)
+		require.Equal(t, want, cmds[i], tt.Subcommand)
+	}
+
+	t.Run("no connection hops", func(t *testing.T) {
+		cmds := channelUpgradeCommands("ibc-0", "ibc-1",
+			ibc.ChannelOutput{PortID: "transfer", ChannelID: "channel-0"},
+			ibc.ChannelOutput{PortID: "transfer", ChannelID: "channel-3"},
+		)
+		for _, cmd := range cmds {
+			require.NotContains(t, cmd, "--dst-connection")
+		}
+	})
+}
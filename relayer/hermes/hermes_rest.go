@@ -0,0 +1,153 @@
+package hermes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+)
+
+// RESTClient queries the REST server of a started hermes relayer, which is enabled in the generated config.
+// Unlike the CLI, the REST server answers from the state of the running relayer, in a versioned JSON format.
+type RESTClient struct {
+	url    string
+	client *http.Client
+}
+
+// NewRESTClient returns a RESTClient for the hermes REST server at url, e.g. "http://127.0.0.1:3000".
+// Usually it is retrieved from a started relayer instead, through (*Relayer).REST.
+func NewRESTClient(url string) *RESTClient {
+	return &RESTClient{
+		url:    url,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// URL returns the base URL of the REST server.
+func (c *RESTClient) URL() string {
+	return c.url
+}
+
+// RESTVersion is the version of a component of hermes.
+type RESTVersion struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// Version returns the versions of the hermes components serving the REST API.
+func (c *RESTClient) Version(ctx context.Context) ([]RESTVersion, error) {
+	var versions []RESTVersion
+	if err := c.get(ctx, "/version", &versions); err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+// Chains returns the IDs of the chains the relayer is configured with.
+func (c *RESTClient) Chains(ctx context.Context) ([]string, error) {
+	var chains []string
+	if err := c.get(ctx, "/chains", &chains); err != nil {
+		return nil, err
+	}
+	return chains, nil
+}
+
+// SupervisorState is the state of the relayer, with the workers it spawned for each kind of object,
+// e.g. "Client", "Connection", "Channel", "Packet" or "Wallet".
+type SupervisorState struct {
+	Chains  []string                `json:"chains"`
+	Workers map[string][]WorkerDesc `json:"workers"`
+}
+
+// WorkerDesc describes a worker of the relayer.
+type WorkerDesc struct {
+	ID     uint64       `json:"id"`
+	Object WorkerObject `json:"object"`
+}
+
+// WorkerObject is the object a worker relays for.
+// The fields which are set depend on the Type of the object.
+type WorkerObject struct {
+	Type string `json:"type"`
+
+	SrcChainID string `json:"src_chain_id"`
+	DstChainID string `json:"dst_chain_id"`
+
+	// Set for Client objects.
+	DstClientID string `json:"dst_client_id"`
+
+	// Set for Connection objects.
+	SrcConnectionID string `json:"src_connection_id"`
+
+	// Set for Channel and Packet objects.
+	SrcChannelID string `json:"src_channel_id"`
+	SrcPortID    string `json:"src_port_id"`
+
+	// Set for Wallet objects.
+	ChainID string `json:"chain_id"`
+}
+
+// State returns the state of the supervisor of the relayer.
+func (c *RESTClient) State(ctx context.Context) (SupervisorState, error) {
+	var state SupervisorState
+	if err := c.get(ctx, "/state", &state); err != nil {
+		return SupervisorState{}, err
+	}
+	return state, nil
+}
+
+// PathWorkers returns the kinds of the workers between chainA and chainB, in either direction, sorted.
+func (s SupervisorState) PathWorkers(chainA, chainB string) []string {
+	var kinds []string
+	for kind, workers := range s.Workers {
+		for _, w := range workers {
+			o := w.Object
+			if (o.SrcChainID == chainA && o.DstChainID == chainB) || (o.SrcChainID == chainB && o.DstChainID == chainA) {
+				kinds = append(kinds, kind)
+				break
+			}
+		}
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+// restResponse is the envelope of every response of the REST server.
+type restResponse struct {
+	Status string          `json:"status"`
+	Result json.RawMessage `json:"result"`
+}
+
+func (c *RESTClient) get(ctx context.Context, path string, result any) error {
+	u, err := url.JoinPath(c.url, path)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("querying hermes REST server: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading hermes REST response: %w", err)
+	}
+
+	var res restResponse
+	if err := json.Unmarshal(body, &res); err != nil {
+		return fmt.Errorf("hermes REST server returned %s: %s", resp.Status, body)
+	}
+	if res.Status != "success" {
+		return fmt.Errorf("hermes REST query %s failed with status %s: %s", path, res.Status, res.Result)
+	}
+	return json.Unmarshal(res.Result, result)
+}
@@ -0,0 +1,94 @@
+package hermes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/relayer"
+)
+
+var _ relayer.StatusReporter = &Relayer{}
+
+// REST returns a RESTClient for the REST server of the relayer started with StartRelayer.
+func (r *Relayer) REST() (*RESTClient, error) {
+	addr, err := r.APIAddress()
+	if err != nil {
+		return nil, err
+	}
+	return NewRESTClient(addr), nil
+}
+
+// PathStatus reports whether the relayer is relaying pathName.
+// The workers of the path come from the state served by the REST server of the started relayer,
+// and the packets from the logs of the relayer.
+func (r *Relayer) PathStatus(ctx context.Context, rep ibc.RelayerExecReporter, pathName string) (relayer.PathStatus, error) {
+	pathConfig, ok := r.paths[pathName]
+	if !ok {
+		return relayer.PathStatus{}, fmt.Errorf("path %s not found", pathName)
+	}
+	chainA, chainB := pathConfig.chainA.chainID, pathConfig.chainB.chainID
+
+	status := relayer.PathStatus{PathName: pathName}
+	status.PacketMessages, status.LastPacket = r.LogEvents().PathActivity(chainA, chainB)
+
+	rest, err := r.REST()
+	if err != nil {
+		// Not started, so not relaying either.
+		return status, nil
+	}
+	state, err := rest.State(ctx)
+	if err != nil {
+		return status, err
+	}
+	status.Workers = state.PathWorkers(chainA, chainB)
+	status.Running = len(status.Workers) > 0
+	return status, nil
+}
+
+// ChainEndpointStatus runs the hermes health check, which verifies that the RPC and gRPC endpoints of chainID
+// are reachable and compatible with hermes.
+func (r *Relayer) ChainEndpointStatus(ctx context.Context, rep ibc.RelayerExecReporter, chainID string) (relayer.ChainEndpointStatus, error) {
+	cmd := []string{hermes, "--json", "health-check"}
+	res := r.Exec(ctx, rep, cmd, nil)
+	if res.Err != nil {
+		return relayer.ChainEndpointStatus{}, res.Err
+	}
+
+	// The results of the checks are logged, rather than part of the json result.
+	output := string(res.Stdout) + "\n" + string(res.Stderr)
+	return parseHealthCheckOutput(chainID, output)
+}
+
+// parseHealthCheckOutput returns the status of chainID from the logs of the hermes health-check command.
+func parseHealthCheckOutput(chainID, output string) (relayer.ChainEndpointStatus, error) {
+	status := relayer.ChainEndpointStatus{ChainID: chainID}
+	var checked bool
+	for _, line := range strings.Split(output, "\n") {
+		_, level, msg, ok := parseHermesLogLine(relayer.StripANSI(strings.TrimSpace(line)))
+		if !ok {
+			continue
+		}
+		if m := hermesChainRe.FindStringSubmatch(msg); m == nil || m[1] != chainID {
+			continue
+		}
+
+		switch {
+		case strings.HasSuffix(msg, "chain is healthy"):
+			checked = true
+			status.Healthy = true
+		case level == "WARN" || level == "ERROR":
+			checked = true
+			status.Healthy = false
+			// The first warning is the cause, the following ones summarize it.
+			if status.Reason == "" {
+				status.Reason = msg
+			}
+		}
+	}
+	if !checked {
+		return status, fmt.Errorf("chain %s not found in hermes health check output", chainID)
+	}
+	return status, nil
+}
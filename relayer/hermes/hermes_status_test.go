@@ -0,0 +1,86 @@
+package hermes
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractJsonResult(t *testing.T) {
+	stdout := []byte(`{"timestamp":"2024-05-01T12:00:00Z","level":"INFO","fields":{"message":"result of the query"}}
+{"result":"Active","status":"success"}
+`)
+	status, err := GetClientStatusFromStdout(stdout)
+	require.NoError(t, err)
+	require.EqualValues(t, "Active", status)
+
+	_, err = GetClientStatusFromStdout([]byte(`{"result":"client not found","status":"error"}`))
+	require.ErrorContains(t, err, "client not found")
+
+	_, err = GetClientStatusFromStdout([]byte("Error: invalid config"))
+	require.Error(t, err)
+}
+
+func TestRESTClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/chains":
+			_, _ = w.Write([]byte(`{"status":"success","result":["ibc-0","ibc-1"]}`))
+		case "/state":
+			_, _ = w.Write([]byte(`{"status":"success","result":{"chains":["ibc-0","ibc-1","ibc-2"],"workers":{
+				"Client":[{"id":1,"object":{"type":"Client","dst_chain_id":"ibc-1","dst_client_id":"07-tendermint-0","src_chain_id":"ibc-0"}}],
+				"Packet":[{"id":2,"object":{"type":"Packet","dst_chain_id":"ibc-0","src_chain_id":"ibc-1","src_channel_id":"channel-0","src_port_id":"transfer"}}],
+				"Wallet":[{"id":3,"object":{"type":"Wallet","chain_id":"ibc-0"}}],
+				"Connection":[{"id":4,"object":{"type":"Connection","dst_chain_id":"ibc-2","src_chain_id":"ibc-0","src_connection_id":"connection-1"}}]
+			}}}`))
+		default:
+			_, _ = w.Write([]byte(`{"status":"error","result":{"name":"NotFound","msg":"not found"}}`))
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := NewRESTClient(srv.URL)
+
+	chains, err := c.Chains(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"ibc-0", "ibc-1"}, chains)
+
+	state, err := c.State(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "transfer", state.Workers["Packet"][0].Object.SrcPortID)
+	require.Equal(t, []string{"Client", "Packet"}, state.PathWorkers("ibc-0", "ibc-1"))
+	require.Equal(t, []string{"Connection"}, state.PathWorkers("ibc-2", "ibc-0"))
+	require.Empty(t, state.PathWorkers("ibc-1", "ibc-2"))
+
+	_, err = c.Version(ctx)
+	require.ErrorContains(t, err, "not found")
+}
+
+func TestParseHealthCheckOutput(t *testing.T) {
+	output := `{"timestamp":"2024-05-01T12:00:00Z","level":"INFO","fields":{"message":"performing health check..."},"span":{"chain":"ibc-0","name":"health_check"}}
+{"timestamp":"2024-05-01T12:00:01Z","level":"INFO","fields":{"message":"chain is healthy"},"span":{"chain":"ibc-0","name":"health_check"}}
+{"timestamp":"2024-05-01T12:00:01Z","level":"ERROR","fields":{"message":"failed to perform health check, reason: error connecting to the gRPC endpoint"},"span":{"chain":"ibc-1","name":"health_check"}}
+{"timestamp":"2024-05-01T12:00:01Z","level":"WARN","fields":{"message":"chain is not healthy"},"span":{"chain":"ibc-1","name":"health_check"}}
+{"result":"performed health check for all chains in the config","status":"success"}`
+
+	status, err := parseHealthCheckOutput("ibc-0", output)
+	require.NoError(t, err)
+	require.True(t, status.Healthy)
+
+	status, err = parseHealthCheckOutput("ibc-1", output)
+	require.NoError(t, err)
+	require.False(t, status.Healthy)
+	require.Contains(t, status.Reason, "error connecting to the gRPC endpoint")
+
+	// The text log format is understood too.
+	status, err = parseHealthCheckOutput("ibc-0", "2024-05-01T12:00:01.000000Z  INFO ThreadId(01) health_check{chain=ibc-0}: chain is healthy")
+	require.NoError(t, err)
+	require.True(t, status.Healthy)
+
+	_, err = parseHealthCheckOutput("ibc-2", output)
+	require.Error(t, err)
+}
@@ -14,6 +14,11 @@ type CreateClientResult struct {
 	CreateClient CreateClient `json:"CreateClient"`
 }
 
+// ClientStatusResponse contains the status of a client, e.g. "Active", "Expired" or "Frozen".
+type ClientStatusResponse struct {
+	Result string `json:"result"`
+}
+
 // ConnectionResponse contains the minimum required values to extract the connection id from both sides.
 type ConnectionResponse struct {
 	Result ConnectionResult `json:"result"`
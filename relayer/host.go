@@ -0,0 +1,486 @@
+package relayer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+)
+
+// hostRelayerStopTimeout is how long StopRelayer waits for the relayer process
+// to exit after an interrupt before killing it.
+const hostRelayerStopTimeout = 10 * time.Second
+
+// HostRelayer provides a common base for relayer implementations
+// that run a local relayer binary as a host process instead of in a Docker container.
+// This makes it possible to attach a debugger to, or quickly rebuild, a relayer under development.
+//
+// It uses the same RelayerCommander as the DockerRelayer of the implementation,
+// with the binary name of every command replaced by the configured binary.
+type HostRelayer struct {
+	log *zap.Logger
+
+	// c defines all the commands to run.
+	c RelayerCommander
+
+	// Path to the relayer binary.
+	binary string
+
+	testName string
+	homeDir  string
+
+	// wallets contains a mapping of chainID to relayer wallet
+	wallets map[string]ibc.Wallet
+
+	// pathConfigs contains the configs applied with ConfigurePath, by path name.
+	pathConfigs map[string]ibc.RelayerPathConfig
+
+	// Events parsed from the output of the processes created by StartRelayer.
+	logEvents *RelayerLogEvents
+
+	// The process created by StartRelayer, guarded by mu.
+	mu        sync.Mutex
+	cmd       *exec.Cmd
+	cmdArgs   []string
+	stdout    *bytes.Buffer
+	stderr    *bytes.Buffer
+	startedAt time.Time
+	exited    chan struct{}
+}
+
+var _ ibc.Relayer = (*HostRelayer)(nil)
+
+// NewHostRelayer returns a new HostRelayer running binary with homeDir as the relayer home directory.
+// homeDir must exist; it is typically a temporary directory owned by the test.
+func NewHostRelayer(ctx context.Context, log *zap.Logger, testName, binary, homeDir string, c RelayerCommander) (*HostRelayer, error) {
+	binary, err := exec.LookPath(binary)
+	if err != nil {
+		return nil, fmt.Errorf("finding relayer binary: %w", err)
+	}
+
+	r := &HostRelayer{
+		log: log,
+
+		c: c,
+
+		binary: binary,
+
+		testName: testName,
+		homeDir:  homeDir,
+
+		wallets: map[string]ibc.Wallet{},
+
+		logEvents: NewRelayerLogEvents(c),
+	}
+
+	if init := r.c.Init(r.HomeDir()); len(init) > 0 {
+		ctx, cancel := context.WithTimeout(ctx, time.Minute)
+		defer cancel()
+
+		// Using a nop reporter here because it keeps the API simpler,
+		// and the init command is typically not of high interest.
+		res := r.Exec(ctx, ibc.NopRelayerExecReporter{}, init, nil)
+		if res.Err != nil {
+			return nil, res.Err
+		}
+	}
+
+	return r, nil
+}
+
+// WriteFileToHomeDir writes the given contents to a file at the relative path specified. The file is relative
+// to the relayer home directory.
+func (r *HostRelayer) WriteFileToHomeDir(_ context.Context, relativePath string, contents []byte) error {
+	p := filepath.Join(r.homeDir, relativePath)
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := os.WriteFile(p, contents, 0o644); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	return nil
+}
+
+// ReadFileFromHomeDir reads a file at the relative path specified and returns the contents. The file is
+// relative to the relayer home directory.
+func (r *HostRelayer) ReadFileFromHomeDir(_ context.Context, relativePath string) ([]byte, error) {
+	bytes, err := os.ReadFile(filepath.Join(r.homeDir, relativePath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve %s: %w", relativePath, err)
+	}
+	return bytes, nil
+}
+
+// AddWallet adds a stores a wallet for the given chain ID.
+func (r *HostRelayer) AddWallet(chainID string, wallet ibc.Wallet) {
+	r.wallets[chainID] = wallet
+}
+
+func (r *HostRelayer) AddChainConfiguration(ctx context.Context, rep ibc.RelayerExecReporter, chainConfig ibc.ChainConfig, keyName, rpcAddr, grpcAddr string) error {
+	chainConfigFile := chainConfig.ChainID + ".config"
+
+	configContent, err := r.c.ConfigContent(ctx, chainConfig, keyName, rpcAddr, grpcAddr)
+	if err != nil {
+		return fmt.Errorf("failed to generate config content: %w", err)
+	}
+
+	if err := r.WriteFileToHomeDir(ctx, chainConfigFile, configContent); err != nil {
+		return err
+	}
+
+	cmd := r.c.AddChainConfiguration(filepath.Join(r.HomeDir(), chainConfigFile), r.HomeDir())
+
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	res := r.Exec(ctx, rep, cmd, nil)
+	return res.Err
+}
+
+func (r *HostRelayer) AddKey(ctx context.Context, rep ibc.RelayerExecReporter, chainID, keyName, coinType, signingAlgorithm string) (ibc.Wallet, error) {
+	cmd := r.c.AddKey(chainID, keyName, coinType, signingAlgorithm, r.HomeDir())
+
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	res := r.Exec(ctx, rep, cmd, nil)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+
+	wallet, err := r.c.ParseAddKeyOutput(string(res.Stdout), string(res.Stderr))
+	if err != nil {
+		return nil, err
+	}
+	r.wallets[chainID] = wallet
+	return wallet, nil
+}
+
+func (r *HostRelayer) GetWallet(chainID string) (ibc.Wallet, bool) {
+	wallet, ok := r.wallets[chainID]
+	return wallet, ok
+}
+
+func (r *HostRelayer) RestoreKey(ctx context.Context, rep ibc.RelayerExecReporter, cfg ibc.ChainConfig, keyName, mnemonic string) error {
+	chainID := cfg.ChainID
+	cmd := r.c.RestoreKey(chainID, keyName, cfg.CoinType, cfg.SigningAlgorithm, mnemonic, r.HomeDir())
+
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	res := r.Exec(ctx, rep, cmd, nil)
+	if res.Err != nil {
+		return res.Err
+	}
+	addrBytes := r.c.ParseRestoreKeyOutput(string(res.Stdout), string(res.Stderr))
+
+	r.wallets[chainID] = r.c.CreateWallet("", addrBytes, mnemonic)
+
+	return nil
+}
+
+func (r *HostRelayer) CreateChannel(ctx context.Context, rep ibc.RelayerExecReporter, pathName string, opts ibc.CreateChannelOptions) error {
+	res := r.Exec(ctx, rep, r.c.CreateChannel(pathName, opts, r.HomeDir()), nil)
+	return res.Err
+}
+
+func (r *HostRelayer) CreateClients(ctx context.Context, rep ibc.RelayerExecReporter, pathName string, opts ibc.CreateClientOptions) error {
+	res := r.Exec(ctx, rep, r.c.CreateClients(pathName, opts, r.HomeDir()), nil)
+	return res.Err
+}
+
+func (r *HostRelayer) CreateClient(ctx context.Context, rep ibc.RelayerExecReporter, srcChainID, dstChainID, pathName string, opts ibc.CreateClientOptions) error {
+	res := r.Exec(ctx, rep, r.c.CreateClient(srcChainID, dstChainID, pathName, opts, r.HomeDir()), nil)
+	return res.Err
+}
+
+func (r *HostRelayer) CreateConnections(ctx context.Context, rep ibc.RelayerExecReporter, pathName string) error {
+	res := r.Exec(ctx, rep, r.c.CreateConnections(pathName, r.HomeDir()), nil)
+	return res.Err
+}
+
+func (r *HostRelayer) Flush(ctx context.Context, rep ibc.RelayerExecReporter, pathName, channelID string) error {
+	res := r.Exec(ctx, rep, r.c.Flush(pathName, channelID, r.HomeDir()), nil)
+	return res.Err
+}
+
+func (r *HostRelayer) GeneratePath(ctx context.Context, rep ibc.RelayerExecReporter, srcChainID, dstChainID, pathName string) error {
+	res := r.Exec(ctx, rep, r.c.GeneratePath(srcChainID, dstChainID, pathName, r.HomeDir()), nil)
+	return res.Err
+}
+
+func (r *HostRelayer) UpdatePath(ctx context.Context, rep ibc.RelayerExecReporter, pathName string, filter ibc.ChannelFilter) error {
+	res := r.Exec(ctx, rep, r.c.UpdatePath(pathName, r.HomeDir(), filter), nil)
+	return res.Err
+}
+
+// ConfigurePath applies cfg to the config file of the relayer, for commanders implementing PathConfigCommander.
+// The settings which the config file cannot hold are passed to StartRelayer.
+func (r *HostRelayer) ConfigurePath(ctx context.Context, rep ibc.RelayerExecReporter, pathName string, cfg ibc.RelayerPathConfig) error {
+	if err := configurePath(ctx, r.c, r, pathName, cfg); err != nil {
+		return err
+	}
+	if r.pathConfigs == nil {
+		r.pathConfigs = make(map[string]ibc.RelayerPathConfig)
+	}
+	r.pathConfigs[pathName] = cfg
+	return nil
+}
+
+func (r *HostRelayer) LinkPath(ctx context.Context, rep ibc.RelayerExecReporter, pathName string, channelOpts ibc.CreateChannelOptions, clientOpts ibc.CreateClientOptions) error {
+	res := r.Exec(ctx, rep, r.c.LinkPath(pathName, r.HomeDir(), channelOpts, clientOpts), nil)
+	return res.Err
+}
+
+func (r *HostRelayer) UpdateClients(ctx context.Context, rep ibc.RelayerExecReporter, pathName string) error {
+	res := r.Exec(ctx, rep, r.c.UpdateClients(pathName, r.HomeDir()), nil)
+	return res.Err
+}
+
+func (r *HostRelayer) GetChannels(ctx context.Context, rep ibc.RelayerExecReporter, chainID string) ([]ibc.ChannelOutput, error) {
+	res := r.Exec(ctx, rep, r.c.GetChannels(chainID, r.HomeDir()), nil)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+	return r.c.ParseGetChannelsOutput(string(res.Stdout), string(res.Stderr))
+}
+
+func (r *HostRelayer) GetConnections(ctx context.Context, rep ibc.RelayerExecReporter, chainID string) (ibc.ConnectionOutputs, error) {
+	res := r.Exec(ctx, rep, r.c.GetConnections(chainID, r.HomeDir()), nil)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+	return r.c.ParseGetConnectionsOutput(string(res.Stdout), string(res.Stderr))
+}
+
+func (r *HostRelayer) GetClients(ctx context.Context, rep ibc.RelayerExecReporter, chainID string) (ibc.ClientOutputs, error) {
+	res := r.Exec(ctx, rep, r.c.GetClients(chainID, r.HomeDir()), nil)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+	return r.c.ParseGetClientsOutput(string(res.Stdout), string(res.Stderr))
+}
+
+func (r *HostRelayer) GetClientStatus(ctx context.Context, rep ibc.RelayerExecReporter, pathName, chainID string) (ibc.ClientStatusOutput, error) {
+	return clientStatus(ctx, r, r.c, rep, pathName, chainID)
+}
+
+// UpgradeChannel is not supported by the generic host relayer.
+func (r *HostRelayer) UpgradeChannel(ctx context.Context, rep ibc.RelayerExecReporter, pathName, channelID string) error {
+	return fmt.Errorf("%s does not support channel upgrades", r.c.Name())
+}
+
+func (r *HostRelayer) SetClientContractHash(ctx context.Context, rep ibc.RelayerExecReporter, cfg ibc.ChainConfig, hash string) error {
+	return fmt.Errorf("%s does not support wasm light clients", r.c.Name())
+}
+
+// Exec runs cmd, as produced by the RelayerCommander, with the relayer binary.
+// env is added to the environment of the current process.
+func (r *HostRelayer) Exec(ctx context.Context, rep ibc.RelayerExecReporter, cmd []string, env []string) ibc.RelayerExecResult {
+	args := r.args(cmd)
+
+	c := exec.CommandContext(ctx, r.binary, args...)
+	c.Env = append(os.Environ(), env...)
+	var stdout, stderr bytes.Buffer
+	c.Stdout = &stdout
+	c.Stderr = &stderr
+
+	startedAt := time.Now()
+	err := c.Run()
+	exitCode := c.ProcessState.ExitCode()
+
+	if err != nil {
+		err = fmt.Errorf("%s %v: %w: %s", r.binary, args, err, stderr.String())
+	}
+
+	rep.TrackRelayerExec(
+		r.Name(),
+		cmd,
+		stdout.String(), stderr.String(),
+		exitCode,
+		startedAt, time.Now(),
+		err,
+	)
+
+	return ibc.RelayerExecResult{
+		Err:      err,
+		ExitCode: exitCode,
+		Stdout:   stdout.Bytes(),
+		Stderr:   stderr.Bytes(),
+	}
+}
+
+// args strips the binary name from a command produced by the RelayerCommander.
+func (r *HostRelayer) args(cmd []string) []string {
+	if len(cmd) == 0 {
+		return nil
+	}
+	return cmd[1:]
+}
+
+// StartRelayer starts the relayer as a background process, which runs until StopRelayer is called.
+func (r *HostRelayer) StartRelayer(ctx context.Context, rep ibc.RelayerExecReporter, pathNames ...string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cmd != nil {
+		return fmt.Errorf("tried to start relayer again without stopping first")
+	}
+
+	cmd := r.c.StartRelayer(r.HomeDir(), pathNames...)
+	startFlags, err := pathStartFlags(r.c, r.pathConfigs, pathNames)
+	if err != nil {
+		return err
+	}
+	cmd = append(cmd, startFlags...)
+
+	// The process must outlive ctx, like the container of a DockerRelayer does.
+	c := exec.Command(r.binary, r.args(cmd)...)
+	r.stdout, r.stderr = new(bytes.Buffer), new(bytes.Buffer)
+	log := r.log.With(zap.String("relayer", r.Name()))
+	stdoutLog := r.logEvents.Writer(log.With(zap.String("stream", "stdout")))
+	stderrLog := r.logEvents.Writer(log.With(zap.String("stream", "stderr")))
+	c.Stdout = io.MultiWriter(&lockedWriter{mu: &r.mu, w: r.stdout}, stdoutLog)
+	c.Stderr = io.MultiWriter(&lockedWriter{mu: &r.mu, w: r.stderr}, stderrLog)
+	// Do not wait on the output of children which outlive the relayer.
+	c.WaitDelay = hostRelayerStopTimeout
+
+	if err := c.Start(); err != nil {
+		return fmt.Errorf("starting relayer: %w", err)
+	}
+
+	r.cmd = c
+	r.cmdArgs = cmd
+	r.startedAt = time.Now()
+	r.exited = make(chan struct{})
+
+	exited := r.exited
+	go func() {
+		_ = c.Wait()
+		_ = stdoutLog.Close()
+		_ = stderrLog.Close()
+		close(exited)
+	}()
+
+	return nil
+}
+
+// StopRelayer interrupts the relayer process, killing it if it does not exit in time,
+// and reports its output.
+func (r *HostRelayer) StopRelayer(ctx context.Context, rep ibc.RelayerExecReporter) error {
+	r.mu.Lock()
+	c, exited := r.cmd, r.exited
+	r.mu.Unlock()
+
+	if c == nil {
+		return nil
+	}
+	// The process is gone on every return path, so a later StartRelayer can start a new one.
+	defer func() {
+		r.mu.Lock()
+		r.cmd = nil
+		r.mu.Unlock()
+	}()
+
+	// Resume the process first in case it was paused, so it can handle the interrupt.
+	_ = resumeProcess(c.Process)
+	if err := c.Process.Signal(os.Interrupt); err != nil && !errors.Is(err, os.ErrProcessDone) {
+		_ = c.Process.Kill()
+	}
+
+	select {
+	case <-exited:
+	case <-time.After(hostRelayerStopTimeout):
+		_ = c.Process.Kill()
+		<-exited
+	case <-ctx.Done():
+		_ = c.Process.Kill()
+		<-exited
+		return ctx.Err()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stdout, stderr := r.stdout.String(), r.stderr.String()
+	rep.TrackRelayerExec(
+		r.Name(),
+		r.cmdArgs,
+		stdout, stderr,
+		c.ProcessState.ExitCode(),
+		r.startedAt,
+		time.Now(),
+		nil,
+	)
+
+	r.log.Debug(
+		fmt.Sprintf("Stopped relayer process\nstdout:\n%s\nstderr:\n%s", stdout, stderr),
+		zap.Int("pid", c.Process.Pid),
+	)
+
+	return nil
+}
+
+// LogEvents returns the events parsed from the output of the relayer,
+// every time it was started with StartRelayer.
+func (r *HostRelayer) LogEvents() *RelayerLogEvents {
+	return r.logEvents
+}
+
+func (r *HostRelayer) PauseRelayer(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.cmd == nil {
+		return fmt.Errorf("relayer process not running")
+	}
+	return pauseProcess(r.cmd.Process)
+}
+
+func (r *HostRelayer) ResumeRelayer(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.cmd == nil {
+		return fmt.Errorf("relayer process not running")
+	}
+	return resumeProcess(r.cmd.Process)
+}
+
+func (r *HostRelayer) GetExtraStartupFlags() []string {
+	return nil
+}
+
+func (r *HostRelayer) Name() string {
+	return r.c.Name() + "-host"
+}
+
+// HomeDir returns the home directory of the relayer on the host filesystem.
+func (r *HostRelayer) HomeDir() string {
+	return r.homeDir
+}
+
+// UseDockerNetwork is false, so the relayer is configured with the host addresses of the chains.
+func (r *HostRelayer) UseDockerNetwork() bool {
+	return false
+}
+
+// lockedWriter serializes writes of the relayer process output with reads of it.
+type lockedWriter struct {
+	mu *sync.Mutex
+	w  *bytes.Buffer
+}
+
+func (w *lockedWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.w.Write(p)
+}
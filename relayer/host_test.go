@@ -0,0 +1,134 @@
+package relayer_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/relayer"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// shCommander produces commands which the HostRelayer runs with sh instead of a relayer binary.
+type shCommander struct {
+	relayer.RelayerCommander
+}
+
+func (shCommander) Name() string { return "sh" }
+
+func (shCommander) Init(homeDir string) []string {
+	return []string{"rly", "-c", "touch " + filepath.Join(homeDir, "initialized")}
+}
+
+func (shCommander) GetChannels(chainID, homeDir string) []string {
+	return []string{"rly", "-c", `echo '[{"channel_id":"channel-0","port_id":"transfer"}]'`}
+}
+
+func (shCommander) ParseGetChannelsOutput(stdout, stderr string) ([]ibc.ChannelOutput, error) {
+	var channels []ibc.ChannelOutput
+	err := json.Unmarshal([]byte(stdout), &channels)
+	return channels, err
+}
+
+func (shCommander) StartRelayer(homeDir string, pathNames ...string) []string {
+	return []string{"rly", "-c", "echo started; echo 'failed to send tx: out of gas' >&2; exec sleep 60"}
+}
+
+func TestHostRelayer(t *testing.T) {
+	ctx := context.Background()
+	home := t.TempDir()
+
+	r, err := relayer.NewHostRelayer(ctx, zap.NewNop(), t.Name(), "sh", home, shCommander{})
+	require.NoError(t, err)
+	require.False(t, r.UseDockerNetwork())
+
+	_, err = os.Stat(filepath.Join(home, "initialized"))
+	require.NoError(t, err, "init command did not run in the home directory")
+
+	channels, err := r.GetChannels(ctx, ibc.NopRelayerExecReporter{}, "chain-a")
+	require.NoError(t, err)
+	require.Len(t, channels, 1)
+	require.Equal(t, "channel-0", channels[0].ChannelID)
+
+	res := r.Exec(ctx, ibc.NopRelayerExecReporter{}, []string{"rly", "-c", "echo oops >&2; exit 3"}, nil)
+	require.Error(t, res.Err)
+	require.Equal(t, 3, res.ExitCode)
+	require.Equal(t, "oops\n", string(res.Stderr))
+
+	require.NoError(t, r.StartRelayer(ctx, ibc.NopRelayerExecReporter{}, "path"))
+	require.Error(t, r.StartRelayer(ctx, ibc.NopRelayerExecReporter{}, "path"))
+	require.Eventually(t, func() bool {
+		return len(r.LogEvents().Errors(relayer.ErrorClassOutOfGas)) == 1
+	}, 5*time.Second, 10*time.Millisecond, "relayer output was not parsed")
+	require.NoError(t, r.PauseRelayer(ctx))
+	require.NoError(t, r.ResumeRelayer(ctx))
+	require.NoError(t, r.StopRelayer(ctx, ibc.NopRelayerExecReporter{}))
+	require.NoError(t, r.StopRelayer(ctx, ibc.NopRelayerExecReporter{}))
+
+	// A relayer stopped with a done context can be started again.
+	require.NoError(t, r.StartRelayer(ctx, ibc.NopRelayerExecReporter{}, "path"))
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	_ = r.StopRelayer(canceled, ibc.NopRelayerExecReporter{})
+	require.NoError(t, r.StartRelayer(ctx, ibc.NopRelayerExecReporter{}, "path"))
+	require.NoError(t, r.StopRelayer(ctx, ibc.NopRelayerExecReporter{}))
+}
+
+// pathConfigCommander records the path configs in its config file, and the start flags in the flags file.
+type pathConfigCommander struct {
+	shCommander
+}
+
+func (pathConfigCommander) ConfigFilePath() string { return "config" }
+
+func (pathConfigCommander) ApplyPathConfig(config []byte, pathName string, cfg ibc.RelayerPathConfig) ([]byte, error) {
+	return append(config, pathName+" "+cfg.Filter.Rule+"\n"...), nil
+}
+
+func (pathConfigCommander) PathStartFlags(cfg ibc.RelayerPathConfig) []string {
+	if cfg.Memo == "" {
+		return nil
+	}
+	return []string{"--memo", cfg.Memo}
+}
+
+func (pathConfigCommander) StartRelayer(homeDir string, pathNames ...string) []string {
+	return []string{"rly", "-c", `echo "$0 $1" > ` + filepath.Join(homeDir, "flags") + "; exec sleep 60"}
+}
+
+func TestHostRelayer_ConfigurePath(t *testing.T) {
+	ctx := context.Background()
+	rep := ibc.NopRelayerExecReporter{}
+	home := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(home, "config"), nil, 0o600))
+
+	r, err := relayer.NewHostRelayer(ctx, zap.NewNop(), t.Name(), "sh", home, pathConfigCommander{})
+	require.NoError(t, err)
+
+	require.NoError(t, r.ConfigurePath(ctx, rep, "a", ibc.RelayerPathConfig{
+		Filter: ibc.ChannelFilter{Rule: ibc.ChannelFilterAllowlist},
+		Memo:   "tuned",
+	}))
+	require.NoError(t, r.ConfigurePath(ctx, rep, "b", ibc.RelayerPathConfig{Memo: "other"}))
+	config, err := os.ReadFile(filepath.Join(home, "config"))
+	require.NoError(t, err)
+	require.Equal(t, "a allowlist\nb \n", string(config))
+
+	require.ErrorContains(t, r.StartRelayer(ctx, rep, "a", "b"), "need different start flags")
+
+	require.NoError(t, r.StartRelayer(ctx, rep, "a", "c"))
+	t.Cleanup(func() { _ = r.StopRelayer(ctx, rep) })
+	require.Eventually(t, func() bool {
+		flags, err := os.ReadFile(filepath.Join(home, "flags"))
+		return err == nil && string(flags) == "--memo tuned\n"
+	}, 5*time.Second, 10*time.Millisecond, "start flags were not passed")
+
+	unsupported, err := relayer.NewHostRelayer(ctx, zap.NewNop(), t.Name(), "sh", t.TempDir(), shCommander{})
+	require.NoError(t, err)
+	require.ErrorContains(t, unsupported.ConfigurePath(ctx, rep, "a", ibc.RelayerPathConfig{}), "does not support path configuration")
+}
@@ -0,0 +1,16 @@
+//go:build !windows
+
+package relayer
+
+import (
+	"os"
+	"syscall"
+)
+
+func pauseProcess(p *os.Process) error {
+	return p.Signal(syscall.SIGSTOP)
+}
+
+func resumeProcess(p *os.Process) error {
+	return p.Signal(syscall.SIGCONT)
+}
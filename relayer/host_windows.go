@@ -0,0 +1,14 @@
+package relayer
+
+import (
+	"errors"
+	"os"
+)
+
+func pauseProcess(*os.Process) error {
+	return errors.New("pausing a host relayer is not supported on windows")
+}
+
+func resumeProcess(*os.Process) error {
+	return nil
+}
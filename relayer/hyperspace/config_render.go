@@ -0,0 +1,30 @@
+package hyperspace
+
+import (
+	"github.com/pelletier/go-toml/v2"
+	"github.com/strangelove-ventures/interchaintest/v8/relayer"
+)
+
+// NewConfigRenderer returns a relayer.ConfigRenderer for hyperspace.
+func NewConfigRenderer() relayer.ConfigRenderer {
+	return &hyperspaceCommander{}
+}
+
+// RenderConfig renders the core config file and the config file of each chain.
+// Paths only exist in interchaintest, not in the config of hyperspace.
+func (c *hyperspaceCommander) RenderConfig(chains []relayer.ConfigChain, paths []relayer.ConfigPath) ([]relayer.ConfigFile, error) {
+	core, err := toml.Marshal(HyperspaceRelayerCoreConfig{PrometheusEndpoint: ""})
+	if err != nil {
+		return nil, err
+	}
+	files := []relayer.ConfigFile{{Path: "core.config", Content: core}}
+
+	for _, chain := range chains {
+		content, err := toml.Marshal(ChainConfigToHyperspaceRelayerChainConfig(chain.Config, chain.KeyName, chain.RPCAddr, chain.GRPCAddr))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, relayer.ConfigFile{Path: chain.Config.ChainID + ".config", Content: content})
+	}
+	return files, nil
+}
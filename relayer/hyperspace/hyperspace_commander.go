@@ -136,6 +136,16 @@ func (hyperspaceCommander) FlushPackets(pathName, channelID, homeDir string) []s
 	panic("[FlushPackets] Do not call me")
 }
 
+// Hyperspace doesn't not have this functionality
+func (hyperspaceCommander) GetClientStatus(pathName, chainID, homeDir string) []string {
+	panic("[GetClientStatus] Do not call me")
+}
+
+// Hyperspace doesn't not have this functionality
+func (hyperspaceCommander) ParseGetClientStatusOutput(chainID, stdout, stderr string) (ibc.ClientStatusOutput, error) {
+	panic("[ParseGetClientStatusOutput] Do not call me")
+}
+
 // GeneratePath establishes an in memory path representation. The concept does not exist in hyperspace.
 func (c *hyperspaceCommander) GeneratePath(srcChainID, dstChainID, pathName, homeDir string) []string {
 	if c.paths == nil {
@@ -14,7 +14,10 @@ import (
 	"go.uber.org/zap"
 )
 
-var _ ibc.Relayer = &HyperspaceRelayer{}
+var (
+	_ ibc.Relayer            = &HyperspaceRelayer{}
+	_ relayer.PathConfigurer = &HyperspaceRelayer{}
+)
 
 // ******* DockerRelayer methods that will panic in hyperspace commander, no overrides yet *******
 // FlushAcknowledgements() - no hyperspace implementation yet
@@ -27,6 +30,9 @@ var _ ibc.Relayer = &HyperspaceRelayer{}
 type HyperspaceRelayer struct {
 	// Embedded DockerRelayer so commands just work.
 	*relayer.DockerRelayer
+
+	// c holds the paths generated through the DockerRelayer.
+	c *hyperspaceCommander
 }
 
 func NewHyperspaceRelayer(log *zap.Logger, testName string, cli *client.Client, networkID string, options ...relayer.RelayerOpt) *HyperspaceRelayer {
@@ -53,6 +59,7 @@ func NewHyperspaceRelayer(log *zap.Logger, testName string, cli *client.Client,
 
 	r := &HyperspaceRelayer{
 		DockerRelayer: dr,
+		c:             &c,
 	}
 
 	return r
@@ -161,6 +168,73 @@ func (r *HyperspaceRelayer) PrintConfigs(ctx context.Context, rep ibc.RelayerExe
 	return nil
 }
 
+// ConfigurePath applies the channel allowlist of cfg to the config of the source chain of the path,
+// and the max gas to the configs of its cosmos chains.
+// Hyperspace has no equivalent for the other settings, so setting them is an error.
+func (r *HyperspaceRelayer) ConfigurePath(ctx context.Context, rep ibc.RelayerExecReporter, pathName string, cfg ibc.RelayerPathConfig) error {
+	pathConfig, ok := r.c.paths[pathName]
+	if !ok {
+		return fmt.Errorf("path %s not found", pathName)
+	}
+	chainA, chainB := pathConfig.chainA.chainID, pathConfig.chainB.chainID
+	if err := cfg.Validate(chainA, chainB); err != nil {
+		return err
+	}
+	if cfg.Filter.Rule == ibc.ChannelFilterDenylist || cfg.ClearInterval != 0 || cfg.MaxMsgsPerTx != 0 || cfg.Memo != "" {
+		return fmt.Errorf("hyperspace only supports channel allowlists and max gas")
+	}
+	for chainID, gas := range cfg.ChainGas {
+		if gas.GasPrices != "" || gas.GasAdjustment != 0 {
+			return fmt.Errorf("hyperspace only supports max gas, not gas prices or adjustment, for chain %s", chainID)
+		}
+	}
+
+	// Both configs are modified before writing either, so that an unsupported setting changes nothing.
+	configs := make(map[string]interface{}, 2)
+	for _, chainID := range []string{chainA, chainB} {
+		chainConfigFile := chainID + ".config"
+		configRaw, err := r.ReadFileFromHomeDir(ctx, chainConfigFile)
+		if err != nil {
+			return err
+		}
+		var chainType struct {
+			Type string `toml:"type"`
+		}
+		if err := toml.Unmarshal(configRaw, &chainType); err != nil {
+			return fmt.Errorf("failed to unmarshal %s: %w", chainConfigFile, err)
+		}
+		config, err := r.GetRelayerChainConfig(ctx, chainConfigFile, chainType.Type)
+		if err != nil {
+			return err
+		}
+
+		switch config := config.(type) {
+		case *HyperspaceRelayerCosmosChainConfig:
+			if chainID == chainA && cfg.Filter.Rule != "" {
+				config.ChannelWhitelist = cfg.Filter.ChannelList
+			}
+			if gas := cfg.ChainGas[chainID]; gas.MaxGas != 0 {
+				config.GasLimit = gas.MaxGas
+			}
+		case *HyperspaceRelayerSubstrateChainConfig:
+			if chainID == chainA && cfg.Filter.Rule != "" {
+				config.ChannelWhitelist = cfg.Filter.ChannelList
+			}
+			if gas := cfg.ChainGas[chainID]; gas.MaxGas != 0 {
+				return fmt.Errorf("hyperspace does not support max gas for substrate chain %s", chainID)
+			}
+		}
+		configs[chainConfigFile] = config
+	}
+
+	for chainConfigFile, config := range configs {
+		if err := r.SetRelayerChainConfig(ctx, chainConfigFile, config); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (r *HyperspaceRelayer) GetRelayerChainConfig(
 	ctx context.Context,
 	filePath string,
@@ -0,0 +1,305 @@
+package relayer
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// LogEventKind is the kind of a RelayerLogEvent.
+type LogEventKind string
+
+const (
+	// LogEventPacketsRelayed is logged when the relayer submitted packet messages successfully.
+	LogEventPacketsRelayed LogEventKind = "packets_relayed"
+
+	// LogEventError is logged when the relayer failed, e.g. to submit a transaction.
+	LogEventError LogEventKind = "error"
+
+	// LogEventRetry is logged when the relayer failed and is going to try again.
+	LogEventRetry LogEventKind = "retry"
+)
+
+// ErrorClass classifies the error of a LogEventError or LogEventRetry event.
+type ErrorClass string
+
+const (
+	ErrorClassOutOfGas          ErrorClass = "out_of_gas"
+	ErrorClassInsufficientFunds ErrorClass = "insufficient_funds"
+	ErrorClassSequenceMismatch  ErrorClass = "sequence_mismatch"
+	ErrorClassClientExpired     ErrorClass = "client_expired"
+
+	// ErrorClassOther is any error which does not fall in another class.
+	ErrorClassOther ErrorClass = "other"
+)
+
+// RelayerLogEvent is a notable line of the logs of a started relayer.
+type RelayerLogEvent struct {
+	// Time the line was logged at, or when it was collected if the line holds no timestamp.
+	Time time.Time
+
+	Kind LogEventKind
+
+	// Set for LogEventError and LogEventRetry events.
+	ErrorClass ErrorClass
+
+	// Chain the event relates to, if known.
+	ChainID string
+
+	// Number of packet messages submitted, for LogEventPacketsRelayed events.
+	Messages int
+
+	// Message of the log line, including the error if any.
+	Message string
+
+	// Line is the raw log line.
+	Line string
+}
+
+// LogCommander is implemented by commanders of relayers whose log format is understood,
+// in order to parse the logs of the started relayer into events.
+// The logs of relayers whose commander does not implement it are classified by ParseLogLine.
+type LogCommander interface {
+	// ParseLogLine returns the event logged by line, or false if line is not a notable event.
+	ParseLogLine(line string) (RelayerLogEvent, bool)
+}
+
+// ParseLogLine parses a log line of unknown format, reporting lines mentioning an error
+// as LogEventError events, and lines mentioning a retry as LogEventRetry events.
+func ParseLogLine(line string) (RelayerLogEvent, bool) {
+	lower := strings.ToLower(line)
+	ev := RelayerLogEvent{Message: line, Line: line}
+	switch {
+	case strings.Contains(lower, "retry"):
+		ev.Kind = LogEventRetry
+	case strings.Contains(lower, "error") || strings.Contains(lower, "failed"):
+		ev.Kind = LogEventError
+	default:
+		return RelayerLogEvent{}, false
+	}
+	ev.ErrorClass = ClassifyError(line)
+	return ev, true
+}
+
+var (
+	outOfGasRe          = regexp.MustCompile(`(?i)out of gas`)
+	insufficientFundsRe = regexp.MustCompile(`(?i)insufficient (funds|fees?)`)
+	sequenceMismatchRe  = regexp.MustCompile(`(?i)(account sequence mismatch|incorrect account sequence)`)
+	clientExpiredRe     = regexp.MustCompile(`(?i)client[^.;]*\b(expired|not active)`)
+)
+
+// ClassifyError returns the class of the error described by msg.
+func ClassifyError(msg string) ErrorClass {
+	switch {
+	case outOfGasRe.MatchString(msg):
+		return ErrorClassOutOfGas
+	case insufficientFundsRe.MatchString(msg):
+		return ErrorClassInsufficientFunds
+	case sequenceMismatchRe.MatchString(msg):
+		return ErrorClassSequenceMismatch
+	case clientExpiredRe.MatchString(msg):
+		return ErrorClassClientExpired
+	default:
+		return ErrorClassOther
+	}
+}
+
+// LogEventSource is implemented by relayers which parse the logs of the started relayer into events,
+// such as DockerRelayer and HostRelayer.
+type LogEventSource interface {
+	LogEvents() *RelayerLogEvents
+}
+
+// RelayerLogEvents collects the events parsed from the logs of a relayer,
+// across every time the relayer was started.
+type RelayerLogEvents struct {
+	parse func(line string) (RelayerLogEvent, bool)
+
+	mu     sync.Mutex
+	events []RelayerLogEvent
+}
+
+// NewRelayerLogEvents returns a RelayerLogEvents parsing lines with the LogCommander implementation of c,
+// or with ParseLogLine if c does not implement LogCommander.
+func NewRelayerLogEvents(c RelayerCommander) *RelayerLogEvents {
+	parse := ParseLogLine
+	if lc, ok := c.(LogCommander); ok {
+		parse = lc.ParseLogLine
+	}
+	return &RelayerLogEvents{parse: parse}
+}
+
+// AddLine parses line, collecting its event if any.
+func (l *RelayerLogEvents) AddLine(line string) {
+	ev, ok := l.parse(line)
+	if !ok {
+		return
+	}
+	if ev.Time.IsZero() {
+		ev.Time = time.Now()
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.events = append(l.events, ev)
+}
+
+// Writer returns a writer which logs every line written to it with log, and collects the events of the lines.
+// Close must be called after the last write, to flush an unterminated last line.
+func (l *RelayerLogEvents) Writer(log *zap.Logger) io.WriteCloser {
+	return &logLineWriter{events: l, log: log}
+}
+
+// Events returns all events collected so far.
+func (l *RelayerLogEvents) Events() []RelayerLogEvent {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]RelayerLogEvent(nil), l.events...)
+}
+
+// Errors returns the LogEventError and LogEventRetry events collected so far,
+// limited to the given classes if any.
+//
+// For instance, this asserts that the relayer never ran out of gas:
+//
+//	require.Empty(t, r.LogEvents().Errors(relayer.ErrorClassOutOfGas))
+func (l *RelayerLogEvents) Errors(classes ...ErrorClass) []RelayerLogEvent {
+	var errs []RelayerLogEvent
+	for _, ev := range l.Events() {
+		if ev.Kind != LogEventError && ev.Kind != LogEventRetry {
+			continue
+		}
+		if len(classes) > 0 && !containsErrorClass(classes, ev.ErrorClass) {
+			continue
+		}
+		errs = append(errs, ev)
+	}
+	return errs
+}
+
+// PacketMessages returns the number of packet messages the relayer logged as submitted.
+func (l *RelayerLogEvents) PacketMessages() int {
+	n := 0
+	for _, ev := range l.Events() {
+		if ev.Kind == LogEventPacketsRelayed {
+			n += ev.Messages
+		}
+	}
+	return n
+}
+
+// Summary describes the errors collected so far in a few lines, one per error class,
+// or returns an empty string if there are none.
+func (l *RelayerLogEvents) Summary() string {
+	type classSummary struct {
+		errors, retries int
+		last            RelayerLogEvent
+	}
+	byClass := make(map[ErrorClass]*classSummary)
+	for _, ev := range l.Errors() {
+		s, ok := byClass[ev.ErrorClass]
+		if !ok {
+			s = new(classSummary)
+			byClass[ev.ErrorClass] = s
+		}
+		if ev.Kind == LogEventRetry {
+			s.retries++
+		} else {
+			s.errors++
+		}
+		s.last = ev
+	}
+	if len(byClass) == 0 {
+		return ""
+	}
+
+	classes := make([]ErrorClass, 0, len(byClass))
+	for c := range byClass {
+		classes = append(classes, c)
+	}
+	sort.Slice(classes, func(i, j int) bool { return classes[i] < classes[j] })
+
+	var b strings.Builder
+	b.WriteString("Relayer log errors:")
+	for _, c := range classes {
+		s := byClass[c]
+		fmt.Fprintf(&b, "\n  %s: %d errors, %d retries; last: %s", c, s.errors, s.retries, truncateLogMessage(s.last.Message))
+	}
+	return b.String()
+}
+
+// maxSummaryMessageLen is how many bytes of a message are included in a Summary.
+const maxSummaryMessageLen = 200
+
+func truncateLogMessage(msg string) string {
+	if len(msg) <= maxSummaryMessageLen {
+		return msg
+	}
+	return msg[:maxSummaryMessageLen] + "..."
+}
+
+func containsErrorClass(classes []ErrorClass, c ErrorClass) bool {
+	for _, class := range classes {
+		if class == c {
+			return true
+		}
+	}
+	return false
+}
+
+// logLineWriter splits the output of a relayer into lines.
+type logLineWriter struct {
+	events *RelayerLogEvents
+	log    *zap.Logger
+
+	buf []byte
+}
+
+func (w *logLineWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+		w.line(string(w.buf[:i]))
+		w.buf = w.buf[i+1:]
+	}
+	return len(p), nil
+}
+
+func (w *logLineWriter) Close() error {
+	if len(w.buf) > 0 {
+		w.line(string(w.buf))
+		w.buf = nil
+	}
+	return nil
+}
+
+func (w *logLineWriter) line(line string) {
+	line = strings.TrimRight(line, "\r")
+	if line == "" {
+		return
+	}
+	w.log.Info("Relayer output", zap.String("line", line))
+	w.events.AddLine(line)
+}
+
+// ansiEscapeRe matches the terminal color codes some relayers log with.
+var ansiEscapeRe = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+// StripANSI removes terminal color codes from a log line.
+func StripANSI(line string) string {
+	return ansiEscapeRe.ReplaceAllString(line, "")
+}
+
+var (
+	_ LogEventSource = (*DockerRelayer)(nil)
+	_ LogEventSource = (*HostRelayer)(nil)
+)
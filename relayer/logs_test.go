@@ -0,0 +1,115 @@
+package relayer_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/strangelove-ventures/interchaintest/v8/relayer"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestClassifyError(t *testing.T) {
+	for msg, want := range map[string]relayer.ErrorClass{
+		"out of gas in location: WritePerByte; gasWanted: 100, gasUsed: 120: out of gas":    relayer.ErrorClassOutOfGas,
+		"0uatom is smaller than 20uatom: insufficient funds":                                relayer.ErrorClassInsufficientFunds,
+		"insufficient fees; got: 1uatom required: 10uatom":                                  relayer.ErrorClassInsufficientFunds,
+		"account sequence mismatch, expected 12, got 11: incorrect account sequence":        relayer.ErrorClassSequenceMismatch,
+		"client state is not active: Expired":                                               relayer.ErrorClassClientExpired,
+		"cannot update client 07-tendermint-0 with status Expired: client state not active": relayer.ErrorClassClientExpired,
+		"connection refused": relayer.ErrorClassOther,
+	} {
+		require.Equal(t, want, relayer.ClassifyError(msg), msg)
+	}
+}
+
+// logCommander parses lines of the form "<kind> <message>".
+type logCommander struct {
+	relayer.RelayerCommander
+}
+
+func (logCommander) ParseLogLine(line string) (relayer.RelayerLogEvent, bool) {
+	kind, msg, _ := strings.Cut(line, " ")
+	ev := relayer.RelayerLogEvent{Kind: relayer.LogEventKind(kind), Message: msg, Line: line}
+	switch ev.Kind {
+	case relayer.LogEventPacketsRelayed:
+		_, err := fmt.Sscan(msg, &ev.Messages)
+		return ev, err == nil
+	case relayer.LogEventError, relayer.LogEventRetry:
+		ev.ErrorClass = relayer.ClassifyError(msg)
+		return ev, true
+	default:
+		return relayer.RelayerLogEvent{}, false
+	}
+}
+
+func TestRelayerLogEvents(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	events := relayer.NewRelayerLogEvents(logCommander{})
+
+	w := events.Writer(zap.New(core))
+	_, err := w.Write([]byte("starting\npackets_relayed 2\nretry account sequence mismatch, expected 2, got 1\nerr"))
+	require.NoError(t, err)
+	_, err = w.Write([]byte("or out of gas\r\n\nerror connection refused"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	// Every line is logged, regardless of whether it is an event.
+	require.Equal(t, 5, logs.Len())
+	require.Equal(t, "error out of gas", logs.All()[3].ContextMap()["line"])
+
+	require.Len(t, events.Events(), 4)
+	require.Equal(t, 2, events.PacketMessages())
+	require.Len(t, events.Errors(), 3)
+	require.Len(t, events.Errors(relayer.ErrorClassOutOfGas, relayer.ErrorClassSequenceMismatch), 2)
+	require.Empty(t, events.Errors(relayer.ErrorClassClientExpired))
+
+	require.Equal(t, `Relayer log errors:
+  other: 1 errors, 0 retries; last: connection refused
+  out_of_gas: 1 errors, 0 retries; last: out of gas
+  sequence_mismatch: 0 errors, 1 retries; last: account sequence mismatch, expected 2, got 1`, events.Summary())
+
+	require.Empty(t, relayer.NewRelayerLogEvents(logCommander{}).Summary())
+}
+
+func TestParseLogLine(t *testing.T) {
+	ev, ok := relayer.ParseLogLine("tx failed: out of gas")
+	require.True(t, ok)
+	require.Equal(t, relayer.LogEventError, ev.Kind)
+	require.Equal(t, relayer.ErrorClassOutOfGas, ev.ErrorClass)
+
+	ev, ok = relayer.ParseLogLine("Error: sequence mismatch, will retry in 1s")
+	require.True(t, ok)
+	require.Equal(t, relayer.LogEventRetry, ev.Kind)
+
+	_, ok = relayer.ParseLogLine("relayed 3 packets")
+	require.False(t, ok)
+}
+
+func TestRelayerLogEvents_PathActivity(t *testing.T) {
+	events := relayer.NewRelayerLogEvents(chainLogCommander{})
+	events.AddLine("ibc-0 2")
+	events.AddLine("ibc-1 3")
+	events.AddLine("ibc-2 5")
+
+	messages, last := events.PathActivity("ibc-0", "ibc-1")
+	require.Equal(t, 5, messages)
+	require.False(t, last.IsZero(), "lines without timestamp are stamped when collected")
+
+	messages, last = events.PathActivity("ibc-3")
+	require.Zero(t, messages)
+	require.True(t, last.IsZero())
+}
+
+// chainLogCommander parses lines of the form "<chain id> <packet messages>".
+type chainLogCommander struct {
+	relayer.RelayerCommander
+}
+
+func (chainLogCommander) ParseLogLine(line string) (relayer.RelayerLogEvent, bool) {
+	ev := relayer.RelayerLogEvent{Kind: relayer.LogEventPacketsRelayed, Line: line}
+	_, err := fmt.Sscan(line, &ev.ChainID, &ev.Messages)
+	return ev, err == nil
+}
@@ -0,0 +1,270 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"go.uber.org/zap"
+
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+)
+
+// MetricsCommander is implemented by commanders of relayers which serve Prometheus metrics
+// while started with StartRelayer. DockerRelayer exposes the metrics port of such relayers.
+type MetricsCommander interface {
+	// MetricsPort is the port of the metrics server inside the relayer container.
+	MetricsPort() string
+
+	// MetricsPath is the HTTP path of the metrics, e.g. "/metrics".
+	MetricsPath() string
+
+	// MetricNames maps common relayer metrics to the names used by the relayer.
+	MetricNames() MetricNames
+}
+
+// MetricsConfigCommander is implemented by a MetricsCommander whose config file must be changed for its metrics
+// server to be reachable from outside the container, e.g. because it listens on the loopback interface by default.
+type MetricsConfigCommander interface {
+	MetricsCommander
+
+	// ConfigFilePath is the path of the config file, relative to the home directory.
+	ConfigFilePath() string
+
+	// ApplyMetricsConfig returns the content of the config file with the metrics server
+	// listening on MetricsPort of all interfaces.
+	ApplyMetricsConfig(config []byte) ([]byte, error)
+}
+
+// configureMetrics applies the metrics config of c to its config file in home, if c implements MetricsConfigCommander.
+func configureMetrics(ctx context.Context, c RelayerCommander, home homeDirFiles) error {
+	mc, ok := c.(MetricsConfigCommander)
+	if !ok {
+		return nil
+	}
+	config, err := home.ReadFileFromHomeDir(ctx, mc.ConfigFilePath())
+	if err != nil {
+		return err
+	}
+	config, err = mc.ApplyMetricsConfig(config)
+	if err != nil {
+		return fmt.Errorf("configuring metrics: %w", err)
+	}
+	return home.WriteFileToHomeDir(ctx, mc.ConfigFilePath(), config)
+}
+
+// MetricNames are the names of the relayer's Prometheus metrics backing the accessors of MetricsSnapshot.
+// A name is empty if the relayer has no such metric.
+type MetricNames struct {
+	PacketsRelayed string
+	TxFailures     string
+	ClientUpdates  string
+
+	// Gauge of the relayer wallet balance, with a "chain" label holding the chain ID.
+	WalletBalance string
+}
+
+// MetricSample is a single value of a metric.
+type MetricSample struct {
+	Name   string
+	Labels map[string]string
+	Value  float64
+}
+
+// MetricsSnapshot holds all metric samples scraped from a relayer at one point in time.
+type MetricsSnapshot struct {
+	Time    time.Time
+	Samples []MetricSample
+
+	names MetricNames
+}
+
+// Sum returns the sum of the samples of the named metric whose labels include all of labels.
+// It returns zero if there are no matching samples.
+func (s MetricsSnapshot) Sum(name string, labels map[string]string) float64 {
+	var sum float64
+	for _, sample := range s.Samples {
+		if sample.Name != name || !hasLabels(sample.Labels, labels) {
+			continue
+		}
+		sum += sample.Value
+	}
+	return sum
+}
+
+// PacketsRelayed returns the number of packet messages the relayer submitted successfully.
+func (s MetricsSnapshot) PacketsRelayed() float64 {
+	return s.Sum(s.names.PacketsRelayed, nil)
+}
+
+// TxFailures returns the number of transactions the relayer failed to submit.
+func (s MetricsSnapshot) TxFailures() float64 {
+	return s.Sum(s.names.TxFailures, nil)
+}
+
+// ClientUpdates returns the number of client updates the relayer submitted.
+func (s MetricsSnapshot) ClientUpdates() float64 {
+	return s.Sum(s.names.ClientUpdates, nil)
+}
+
+// WalletBalance returns the balance of the relayer wallet on chainID, as last observed by the relayer.
+func (s MetricsSnapshot) WalletBalance(chainID string) float64 {
+	return s.Sum(s.names.WalletBalance, map[string]string{"chain": chainID})
+}
+
+func hasLabels(labels, want map[string]string) bool {
+	for k, v := range want {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// RelayerMetrics scrapes the Prometheus metrics of a running relayer.
+type RelayerMetrics struct {
+	log   *zap.Logger
+	url   string
+	names MetricNames
+
+	client *http.Client
+}
+
+// NewRelayerMetrics returns a RelayerMetrics scraping the Prometheus text exposition format served at url.
+// Usually it is retrieved from a started relayer instead, e.g. through (*DockerRelayer).Metrics.
+func NewRelayerMetrics(log *zap.Logger, url string, names MetricNames) *RelayerMetrics {
+	return &RelayerMetrics{
+		log:    log,
+		url:    url,
+		names:  names,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// URL returns the URL the metrics are scraped from.
+func (m *RelayerMetrics) URL() string {
+	return m.url
+}
+
+// Snapshot scrapes the current value of every metric.
+func (m *RelayerMetrics) Snapshot(ctx context.Context) (MetricsSnapshot, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, m.url, nil)
+	if err != nil {
+		return MetricsSnapshot{}, err
+	}
+	res, err := m.client.Do(req)
+	if err != nil {
+		return MetricsSnapshot{}, fmt.Errorf("scraping relayer metrics: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return MetricsSnapshot{}, fmt.Errorf("scraping relayer metrics: unexpected status %s", res.Status)
+	}
+
+	samples, err := parseMetrics(res.Body)
+	if err != nil {
+		return MetricsSnapshot{}, err
+	}
+	return MetricsSnapshot{Time: time.Now(), Samples: samples, names: m.names}, nil
+}
+
+// Stream scrapes the metrics every interval until ctx is done, and sends each snapshot on the returned channel.
+// The channel is closed once ctx is done. Failed scrapes are logged and skipped,
+// since the metrics server may not be up yet right after the relayer started.
+func (m *RelayerMetrics) Stream(ctx context.Context, interval time.Duration) <-chan MetricsSnapshot {
+	ch := make(chan MetricsSnapshot)
+	go func() {
+		defer close(ch)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			snapshot, err := m.Snapshot(ctx)
+			if err != nil {
+				m.log.Debug("Failed to scrape relayer metrics", zap.String("url", m.url), zap.Error(err))
+			} else {
+				select {
+				case ch <- snapshot:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+// MetricsReporter receives relayer metric time series,
+// and is satisfied by *testreporter.RelayerExecReporter.
+type MetricsReporter interface {
+	TrackRelayerMetrics(relayerName string, samples []testreporter.RelayerMetricSample)
+}
+
+// ReportMetrics attaches the time series formed by snapshots to the test report.
+func ReportMetrics(rep MetricsReporter, relayerName string, snapshots []MetricsSnapshot) {
+	var samples []testreporter.RelayerMetricSample
+	for _, snapshot := range snapshots {
+		for _, s := range snapshot.Samples {
+			samples = append(samples, testreporter.RelayerMetricSample{
+				When:   snapshot.Time,
+				Metric: s.Name,
+				Labels: s.Labels,
+				Value:  s.Value,
+			})
+		}
+	}
+	rep.TrackRelayerMetrics(relayerName, samples)
+}
+
+// parseMetrics flattens the Prometheus text exposition format into samples, sorted by name.
+// Summaries and histograms are reduced to their _sum and _count samples.
+func parseMetrics(r io.Reader) ([]MetricSample, error) {
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(r)
+	if err != nil {
+		return nil, fmt.Errorf("parsing relayer metrics: %w", err)
+	}
+
+	var samples []MetricSample
+	for name, family := range families {
+		for _, metric := range family.GetMetric() {
+			labels := make(map[string]string, len(metric.GetLabel()))
+			for _, l := range metric.GetLabel() {
+				labels[l.GetName()] = l.GetValue()
+			}
+
+			switch family.GetType() {
+			case dto.MetricType_COUNTER:
+				samples = append(samples, MetricSample{Name: name, Labels: labels, Value: metric.GetCounter().GetValue()})
+			case dto.MetricType_GAUGE:
+				samples = append(samples, MetricSample{Name: name, Labels: labels, Value: metric.GetGauge().GetValue()})
+			case dto.MetricType_UNTYPED:
+				samples = append(samples, MetricSample{Name: name, Labels: labels, Value: metric.GetUntyped().GetValue()})
+			case dto.MetricType_SUMMARY:
+				samples = append(samples,
+					MetricSample{Name: name + "_sum", Labels: labels, Value: metric.GetSummary().GetSampleSum()},
+					MetricSample{Name: name + "_count", Labels: labels, Value: float64(metric.GetSummary().GetSampleCount())},
+				)
+			case dto.MetricType_HISTOGRAM, dto.MetricType_GAUGE_HISTOGRAM:
+				samples = append(samples,
+					MetricSample{Name: name + "_sum", Labels: labels, Value: metric.GetHistogram().GetSampleSum()},
+					MetricSample{Name: name + "_count", Labels: labels, Value: float64(metric.GetHistogram().GetSampleCount())},
+				)
+			}
+		}
+	}
+
+	sort.SliceStable(samples, func(i, j int) bool { return samples[i].Name < samples[j].Name })
+	return samples, nil
+}
@@ -0,0 +1,79 @@
+package relayer_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/strangelove-ventures/interchaintest/v8/relayer"
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const rlyMetrics = `# HELP cosmos_relayer_relayed_packets The total number of packets relayed
+# TYPE cosmos_relayer_relayed_packets counter
+cosmos_relayer_relayed_packets{chain="chain-a",channel="channel-0",path_name="p",port="transfer",type="MsgRecvPacket"} 3
+cosmos_relayer_relayed_packets{chain="chain-b",channel="channel-0",path_name="p",port="transfer",type="MsgAcknowledgement"} 2
+# HELP cosmos_relayer_wallet_balance The current balance for the relayer's wallet
+# TYPE cosmos_relayer_wallet_balance gauge
+cosmos_relayer_wallet_balance{address="cosmos1abc",chain="chain-a",denom="uatom",gas_price="0.01uatom",key="k"} 999
+# HELP cosmos_relayer_tx_latency Latency of submitted transactions
+# TYPE cosmos_relayer_tx_latency histogram
+cosmos_relayer_tx_latency_bucket{le="1"} 1
+cosmos_relayer_tx_latency_bucket{le="+Inf"} 2
+cosmos_relayer_tx_latency_sum 1.5
+cosmos_relayer_tx_latency_count 2
+`
+
+type metricsReporter struct {
+	relayer string
+	samples []testreporter.RelayerMetricSample
+}
+
+func (r *metricsReporter) TrackRelayerMetrics(relayerName string, samples []testreporter.RelayerMetricSample) {
+	r.relayer = relayerName
+	r.samples = samples
+}
+
+func TestRelayerMetrics(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/relayer/metrics", r.URL.Path)
+		_, _ = w.Write([]byte(rlyMetrics))
+	}))
+	defer srv.Close()
+
+	m := relayer.NewRelayerMetrics(zap.NewNop(), srv.URL+"/relayer/metrics", relayer.MetricNames{
+		PacketsRelayed: "cosmos_relayer_relayed_packets",
+		TxFailures:     "cosmos_relayer_tx_errors_total",
+		WalletBalance:  "cosmos_relayer_wallet_balance",
+	})
+
+	ctx := context.Background()
+	snapshot, err := m.Snapshot(ctx)
+	require.NoError(t, err)
+
+	require.Equal(t, float64(5), snapshot.PacketsRelayed())
+	require.Equal(t, float64(3), snapshot.Sum("cosmos_relayer_relayed_packets", map[string]string{"type": "MsgRecvPacket"}))
+	require.Equal(t, float64(999), snapshot.WalletBalance("chain-a"))
+	require.Zero(t, snapshot.WalletBalance("chain-b"))
+	require.Zero(t, snapshot.TxFailures())
+	require.Zero(t, snapshot.ClientUpdates())
+	require.Equal(t, float64(2), snapshot.Sum("cosmos_relayer_tx_latency_count", nil))
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	stream := m.Stream(streamCtx, 10*time.Millisecond)
+	snapshots := []relayer.MetricsSnapshot{<-stream, <-stream}
+	cancel()
+	for range stream {
+		// Drain until the stream is closed.
+	}
+
+	var rep metricsReporter
+	relayer.ReportMetrics(&rep, "rly", snapshots)
+	require.Equal(t, "rly", rep.relayer)
+	require.Len(t, rep.samples, 2*len(snapshot.Samples))
+	require.Equal(t, snapshots[0].Time, rep.samples[0].When)
+}
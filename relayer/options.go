@@ -39,6 +39,15 @@ func ImagePull(pull bool) RelayerOpt {
 	}
 }
 
+// PacketMetrics makes relayers which only count relayed packets when configured to do so,
+// such as hermes which then waits for the confirmation of each tx, report them in their metrics.
+// It changes how such relayers relay, so it is off by default.
+func PacketMetrics() RelayerOpt {
+	return func(r *DockerRelayer) {
+		r.packetMetrics = true
+	}
+}
+
 // StartupFlags overrides the default relayer startup flags.
 func StartupFlags(flags ...string) RelayerOpt {
 	return func(r *DockerRelayer) {
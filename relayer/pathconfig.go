@@ -0,0 +1,85 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+)
+
+// PathConfigurer is implemented by relayers which apply an ibc.RelayerPathConfig to a path.
+// The path must have been generated, and the settings take effect the next time the path is started.
+type PathConfigurer interface {
+	ConfigurePath(ctx context.Context, rep ibc.RelayerExecReporter, pathName string, cfg ibc.RelayerPathConfig) error
+}
+
+var (
+	_ PathConfigurer = (*DockerRelayer)(nil)
+	_ PathConfigurer = (*HostRelayer)(nil)
+)
+
+// PathConfigCommander is implemented by commanders of relayers whose config file holds their paths,
+// in order to apply an ibc.RelayerPathConfig through DockerRelayer and HostRelayer.
+type PathConfigCommander interface {
+	// ConfigFilePath is the path of the config file, relative to the home directory.
+	ConfigFilePath() string
+
+	// ApplyPathConfig returns the content of the config file with cfg applied to pathName.
+	ApplyPathConfig(config []byte, pathName string, cfg ibc.RelayerPathConfig) ([]byte, error)
+
+	// PathStartFlags returns the flags of the start command for the settings of cfg
+	// which are not held by the config file.
+	PathStartFlags(cfg ibc.RelayerPathConfig) []string
+}
+
+// homeDirFiles reads and writes files relative to the home directory of a relayer.
+type homeDirFiles interface {
+	ReadFileFromHomeDir(ctx context.Context, relativePath string) ([]byte, error)
+	WriteFileToHomeDir(ctx context.Context, relativePath string, contents []byte) error
+}
+
+// configurePath applies cfg to the config file of the relayer in home, through the PathConfigCommander of c.
+func configurePath(ctx context.Context, c RelayerCommander, home homeDirFiles, pathName string, cfg ibc.RelayerPathConfig) error {
+	pc, ok := c.(PathConfigCommander)
+	if !ok {
+		return fmt.Errorf("%s does not support path configuration", c.Name())
+	}
+
+	config, err := home.ReadFileFromHomeDir(ctx, pc.ConfigFilePath())
+	if err != nil {
+		return err
+	}
+	config, err = pc.ApplyPathConfig(config, pathName, cfg)
+	if err != nil {
+		return fmt.Errorf("configuring path %s: %w", pathName, err)
+	}
+	return home.WriteFileToHomeDir(ctx, pc.ConfigFilePath(), config)
+}
+
+// pathStartFlags returns the start flags for the configs of pathNames.
+// The flags apply to the whole relayer process, so paths started together must not need different flags.
+func pathStartFlags(c RelayerCommander, configs map[string]ibc.RelayerPathConfig, pathNames []string) ([]string, error) {
+	pc, ok := c.(PathConfigCommander)
+	if !ok {
+		return nil, nil
+	}
+
+	var flags []string
+	var flagsPath string
+	for _, pathName := range pathNames {
+		cfg, ok := configs[pathName]
+		if !ok {
+			continue
+		}
+		pathFlags := pc.PathStartFlags(cfg)
+		if len(pathFlags) == 0 {
+			continue
+		}
+		if flags != nil && !slices.Equal(flags, pathFlags) {
+			return nil, fmt.Errorf("paths %s and %s need different start flags: %v and %v", flagsPath, pathName, flags, pathFlags)
+		}
+		flags, flagsPath = pathFlags, pathName
+	}
+	return flags, nil
+}
@@ -0,0 +1,61 @@
+package rly
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/strangelove-ventures/interchaintest/v8/relayer"
+	"gopkg.in/yaml.v3"
+)
+
+// NewConfigRenderer returns a relayer.ConfigRenderer for rly.
+func NewConfigRenderer() relayer.ConfigRenderer {
+	return commander{}
+}
+
+// RenderConfig renders the chain config files added with rly chains add, and the chains and paths of
+// config/config.yaml as rly chains add and rly paths new store them, with the settings of the paths applied.
+// The global section of config/config.yaml is left out: it holds the defaults of rly config init,
+// which interchaintest does not set.
+func (c commander) RenderConfig(chains []relayer.ConfigChain, paths []relayer.ConfigPath) ([]relayer.ConfigFile, error) {
+	var files []relayer.ConfigFile
+	configChains := make(map[string]any, len(chains))
+	for _, chain := range chains {
+		content, err := c.ConfigContent(context.Background(), chain.Config, chain.KeyName, chain.RPCAddr, chain.GRPCAddr)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, relayer.ConfigFile{Path: chain.Config.ChainID + ".config", Content: content})
+
+		// rly chains add names the chain after the config file.
+		var configChain map[string]any
+		if err := json.Unmarshal(content, &configChain); err != nil {
+			return nil, err
+		}
+		configChains[chain.Config.ChainID] = configChain
+	}
+
+	configPaths := make(map[string]any, len(paths))
+	for _, path := range paths {
+		configPaths[path.Name] = map[string]any{
+			"src": map[string]any{"chain-id": path.SrcChainID},
+			"dst": map[string]any{"chain-id": path.DstChainID},
+			"src-channel-filter": map[string]any{
+				"rule":         "",
+				"channel-list": []string{},
+			},
+		}
+	}
+
+	config, err := yaml.Marshal(map[string]any{"chains": configChains, "paths": configPaths})
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		if config, err = c.ApplyPathConfig(config, path.Name, path.Config); err != nil {
+			return nil, fmt.Errorf("configuring path %s: %w", path.Name, err)
+		}
+	}
+	return append(files, relayer.ConfigFile{Path: c.ConfigFilePath(), Content: config}), nil
+}
@@ -41,6 +41,12 @@ func NewCosmosRelayer(log *zap.Logger, testName string, cli *client.Client, netw
 	return r
 }
 
+// NewHostRelayer returns a relayer running the rly binary at the given path as a host process,
+// with homeDir as its home directory. This is useful when developing the relayer itself.
+func NewHostRelayer(ctx context.Context, log *zap.Logger, testName, binary, homeDir string) (*relayer.HostRelayer, error) {
+	return relayer.NewHostRelayer(ctx, log, testName, binary, homeDir, &commander{log: log})
+}
+
 type CosmosRelayerChainConfigValue struct {
 	AccountPrefix  string  `json:"account-prefix"`
 	ChainID        string  `json:"chain-id"`
@@ -71,8 +77,12 @@ const (
 // Note, this API may change if the rly package eventually needs
 // to distinguish between multiple rly versions.
 func Capabilities() map[relayer.Capability]bool {
-	// RC1 matches the full set of capabilities as of writing.
-	return relayer.FullCapabilities()
+	caps := relayer.FullCapabilities()
+
+	// rly has no command to step through the channel upgrade handshake.
+	caps[relayer.ChannelUpgrade] = false
+
+	return caps
 }
 
 func ChainConfigToCosmosRelayerChainConfig(chainConfig ibc.ChainConfig, keyName, rpcAddr, gprcAddr string) CosmosRelayerChainConfig {
@@ -99,16 +109,37 @@ func ChainConfigToCosmosRelayerChainConfig(chainConfig ibc.ChainConfig, keyName,
 	}
 }
 
-// commander satisfies relayer.RelayerCommander.
+// commander satisfies relayer.RelayerCommander, relayer.MetricsCommander, relayer.LogCommander
+// and relayer.ClientStateCommander.
 type commander struct {
 	log             *zap.Logger
 	extraStartFlags []string
 }
 
+var _ relayer.ClientStateCommander = (*commander)(nil)
+
 func (commander) Name() string {
 	return "rly"
 }
 
+// MetricsPort is the port of the default api-listen-addr in the config created by rly config init,
+// which serves the debug and metrics endpoints.
+func (commander) MetricsPort() string {
+	return "5183"
+}
+
+func (commander) MetricsPath() string {
+	return "/relayer/metrics"
+}
+
+func (commander) MetricNames() relayer.MetricNames {
+	return relayer.MetricNames{
+		PacketsRelayed: "cosmos_relayer_relayed_packets",
+		TxFailures:     "cosmos_relayer_tx_errors_total",
+		WalletBalance:  "cosmos_relayer_wallet_balance",
+	}
+}
+
 func (commander) DockerUser() string {
 	return RlyDefaultUidGid // docker run -it --rm --entrypoint echo ghcr.io/cosmos/relayer "$(id -u):$(id -g)"
 }
@@ -230,6 +261,13 @@ func (commander) GetClients(chainID, homeDir string) []string {
 	}
 }
 
+func (commander) GetClientStatus(pathName, chainID, homeDir string) []string {
+	return []string{
+		"rly", "q", "clients-expiration", pathName,
+		"--home", homeDir,
+	}
+}
+
 func (commander) LinkPath(pathName, homeDir string, channelOpts ibc.CreateChannelOptions, clientOpt ibc.CreateClientOptions) []string {
 	cmd := []string{
 		"rly", "tx", "link", pathName,
@@ -364,6 +402,77 @@ func (c commander) ParseGetClientsOutput(stdout, stderr string) (ibc.ClientOutpu
 	return clients, nil
 }
 
+// ParseGetClientStatusOutput extracts the status of the client hosted on chainID
+// from the output of "rly q clients-expiration", which reports each end of the path as:
+//
+//	client: 07-tendermint-0 (chain-a)
+//	  HEALTH:              GOOD
+//	  ...
+//
+// rly only tracks expiry, so a GOOD client is reported as ibc.ClientStatusUnknown,
+// and told apart from a frozen client through the client state, see GetClientState.
+func (commander) ParseGetClientStatusOutput(chainID, stdout, stderr string) (ibc.ClientStatusOutput, error) {
+	out := ibc.ClientStatusOutput{ChainID: chainID, Status: ibc.ClientStatusUnknown}
+	found := false
+	for _, line := range strings.Split(stdout, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "client:") {
+			fields := strings.Fields(strings.TrimPrefix(line, "client:"))
+			found = len(fields) == 2 && fields[1] == "("+chainID+")"
+			if found {
+				out.ClientID = fields[0]
+			}
+			continue
+		}
+		if !found || !strings.HasPrefix(line, "HEALTH:") {
+			continue
+		}
+		switch strings.TrimSpace(strings.TrimPrefix(line, "HEALTH:")) {
+		case "EXPIRED":
+			out.Status = ibc.ClientStatusExpired
+		}
+		return out, nil
+	}
+	if out.ClientID == "" {
+		return out, fmt.Errorf("no client found on chain %s", chainID)
+	}
+	return out, nil
+}
+
+func (commander) GetClientState(chainID, clientID, homeDir string) []string {
+	return []string{
+		"rly", "q", "client", chainID, clientID,
+		"--home", homeDir,
+	}
+}
+
+// ParseClientFrozen tells whether the client state output by "rly q client" has a frozen height,
+// which is set once misbehaviour is submitted for the client.
+func (commander) ParseClientFrozen(stdout, stderr string) (bool, error) {
+	var res struct {
+		ClientState struct {
+			FrozenHeight *struct {
+				RevisionNumber json.RawMessage `json:"revision_number"`
+				RevisionHeight json.RawMessage `json:"revision_height"`
+			} `json:"frozen_height"`
+		} `json:"client_state"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &res); err != nil {
+		return false, fmt.Errorf("parse client state: %w", err)
+	}
+	frozen := res.ClientState.FrozenHeight
+	if frozen == nil {
+		return false, nil
+	}
+	// Heights are numbers encoded as strings, which are omitted when zero.
+	for _, v := range []json.RawMessage{frozen.RevisionNumber, frozen.RevisionHeight} {
+		if n := strings.Trim(string(v), `"`); n != "" && n != "0" && n != "null" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (commander) Init(homeDir string) []string {
 	return []string{
 		"rly", "config", "init",
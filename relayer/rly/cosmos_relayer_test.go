@@ -0,0 +1,169 @@
+package rly
+
+import (
+	"testing"
+	"time"
+
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/relayer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommander_ParseGetClientStatusOutput(t *testing.T) {
+	const stdout = `client: 07-tendermint-0 (chain-a)
+  HEALTH:              GOOD
+  TIME:                2024-01-02 15:04:05 +0000 UTC (335h59m50s)
+  LAST UPDATE HEIGHT:  42
+  TRUSTING PERIOD:     336h0m0s
+client: 07-tendermint-1 (chain-b)
+  HEALTH:              EXPIRED
+  TIME:                2024-01-01 15:04:05 +0000 UTC (0s)
+  LAST UPDATE HEIGHT:  40
+  TRUSTING PERIOD:     30s
+`
+	var c commander
+
+	status, err := c.ParseGetClientStatusOutput("chain-a", stdout, "")
+	require.NoError(t, err)
+	require.Equal(t, ibc.ClientStatusOutput{ClientID: "07-tendermint-0", ChainID: "chain-a", Status: ibc.ClientStatusUnknown}, status)
+
+	status, err = c.ParseGetClientStatusOutput("chain-b", stdout, "")
+	require.NoError(t, err)
+	require.Equal(t, ibc.ClientStatusOutput{ClientID: "07-tendermint-1", ChainID: "chain-b", Status: ibc.ClientStatusExpired}, status)
+
+	_, err = c.ParseGetClientStatusOutput("chain-c", stdout, "")
+	require.Error(t, err)
+}
+
+func TestCommander_ParseClientFrozen(t *testing.T) {
+	var c commander
+
+	for _, tt := range []struct {
+		name   string
+		stdout string
+		frozen bool
+	}{
+		{"zero height", `{"client_state":{"@type":"/ibc.lightclients.tendermint.v1.ClientState","chain_id":"chain-b","frozen_height":{"revision_number":"0","revision_height":"0"}}}`, false},
+		{"omitted zeros", `{"client_state":{"chain_id":"chain-b","frozen_height":{}}}`, false},
+		{"no frozen height", `{"client_state":{"chain_id":"chain-b"}}`, false},
+		{"frozen", `{"client_state":{"chain_id":"chain-b","frozen_height":{"revision_number":"0","revision_height":"1"}}}`, true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			frozen, err := c.ParseClientFrozen(tt.stdout, "")
+			require.NoError(t, err)
+			require.Equal(t, tt.frozen, frozen)
+		})
+	}
+
+	_, err := c.ParseClientFrozen("not json", "")
+	require.Error(t, err)
+}
+
+func TestCommander_ParseLogLine(t *testing.T) {
+	var c commander
+
+	ev, ok := c.ParseLogLine("2024-05-01T12:00:00.123456Z\tinfo\tSuccessful transaction\t" +
+		`{"provider_type": "cosmos", "chain_id": "chain-b", "gas_used": 123456, "height": 42, ` +
+		`"msg_types": ["/ibc.core.client.v1.MsgUpdateClient", "/ibc.core.channel.v1.MsgRecvPacket", "/ibc.core.channel.v1.MsgRecvPacket"], "tx_hash": "ABCD"}`)
+	require.True(t, ok)
+	require.Equal(t, relayer.LogEventPacketsRelayed, ev.Kind)
+	require.Equal(t, "chain-b", ev.ChainID)
+	require.Equal(t, 2, ev.Messages)
+	require.Equal(t, time.Date(2024, 5, 1, 12, 0, 0, 123456000, time.UTC), ev.Time)
+
+	ev, ok = c.ParseLogLine("2024-05-01T12:00:01.000000Z\tinfo\tError building or broadcasting transaction\t" +
+		`{"provider_type": "cosmos", "chain_id": "chain-a", "attempt": 1, "max_attempts": 5, "error": "account sequence mismatch, expected 7, got 6: incorrect account sequence"}`)
+	require.True(t, ok)
+	require.Equal(t, relayer.LogEventRetry, ev.Kind)
+	require.Equal(t, relayer.ErrorClassSequenceMismatch, ev.ErrorClass)
+	require.Equal(t, "Error building or broadcasting transaction: account sequence mismatch, expected 7, got 6: incorrect account sequence", ev.Message)
+
+	ev, ok = c.ParseLogLine(`{"lvl":"error","ts":"2024-05-01T12:00:02.000000Z","msg":"Failed sending cosmos transaction","chain_id":"chain-a","error":"out of gas in location: ReadFlat; gasWanted: 10, gasUsed: 20: out of gas"}`)
+	require.True(t, ok)
+	require.Equal(t, relayer.LogEventError, ev.Kind)
+	require.Equal(t, relayer.ErrorClassOutOfGas, ev.ErrorClass)
+	require.Equal(t, "chain-a", ev.ChainID)
+
+	_, ok = c.ParseLogLine("2024-05-01T12:00:03.000000Z\tinfo\tChain is in sync\t" + `{"chain_name": "chain-a", "chain_id": "chain-a"}`)
+	require.False(t, ok)
+
+	_, ok = c.ParseLogLine("2024-05-01T12:00:04.000000Z\tinfo\tSuccessful transaction\t" + `{"chain_id": "chain-a", "msg_types": ["/ibc.core.client.v1.MsgUpdateClient"]}`)
+	require.False(t, ok, "client updates are not packets")
+}
+
+func TestCommander_ApplyPathConfig(t *testing.T) {
+	const config = `global:
+    api-listen-addr: 127.0.0.1:5183
+chains:
+    chain-a:
+        type: cosmos
+        value:
+            chain-id: chain-a
+            gas-adjustment: 1.3
+            gas-prices: 0.01uatom
+    chain-b:
+        type: cosmos
+        value:
+            chain-id: chain-b
+            gas-adjustment: 1.3
+            gas-prices: 0.01stake
+paths:
+    a-b:
+        src:
+            chain-id: chain-a
+        dst:
+            chain-id: chain-b
+        src-channel-filter:
+            rule: ""
+            channel-list: []
+`
+	var c commander
+
+	out, err := c.ApplyPathConfig([]byte(config), "a-b", ibc.RelayerPathConfig{
+		Filter: ibc.ChannelFilter{Rule: ibc.ChannelFilterAllowlist, ChannelList: []string{"channel-0"}},
+		ChainGas: map[string]ibc.ChainGasConfig{
+			"chain-b": {GasPrices: "0.1stake", MaxGas: 500000},
+		},
+	})
+	require.NoError(t, err)
+	require.Contains(t, string(out), `        src-channel-filter:
+            channel-list:
+                - channel-0
+            rule: allowlist`)
+	require.Contains(t, string(out), `            gas-adjustment: 1.3
+            gas-prices: 0.1stake
+            max-gas-amount: 500000`)
+	require.Contains(t, string(out), "gas-prices: 0.01uatom")
+
+	_, err = c.ApplyPathConfig([]byte(config), "b-c", ibc.RelayerPathConfig{})
+	require.ErrorContains(t, err, "[paths b-c] not found")
+
+	_, err = c.ApplyPathConfig([]byte(config), "a-b", ibc.RelayerPathConfig{
+		ChainGas: map[string]ibc.ChainGasConfig{"chain-c": {GasAdjustment: 2}},
+	})
+	require.ErrorContains(t, err, "chain chain-c is not part of the path")
+
+	require.Equal(t,
+		[]string{"--flush-interval", "5m0s", "--max-msgs", "10", "--memo", "tuned"},
+		c.PathStartFlags(ibc.RelayerPathConfig{ClearInterval: 5 * time.Minute, MaxMsgsPerTx: 10, Memo: "tuned"}),
+	)
+	require.Empty(t, c.PathStartFlags(ibc.RelayerPathConfig{}))
+}
+
+func TestCommander_ApplyMetricsConfig(t *testing.T) {
+	const config = `global:
+    api-listen-addr: 127.0.0.1:5183
+    timeout: 10s
+chains: {}
+paths: {}
+`
+	var c commander
+
+	out, err := c.ApplyMetricsConfig([]byte(config))
+	require.NoError(t, err)
+	require.Contains(t, string(out), "api-listen-addr: 0.0.0.0:5183")
+	require.Contains(t, string(out), "timeout: 10s")
+
+	_, err = c.ApplyMetricsConfig([]byte("chains: {}\n"))
+	require.Error(t, err)
+}
@@ -0,0 +1,116 @@
+package rly
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/strangelove-ventures/interchaintest/v8/relayer"
+)
+
+// rlyLogLine holds the fields of a rly log line which are used to classify it.
+type rlyLogLine struct {
+	Time    string
+	Level   string
+	Message string
+
+	ChainID     string   `json:"chain_id"`
+	Error       string   `json:"error"`
+	RawLog      string   `json:"raw_log"`
+	MsgTypes    []string `json:"msg_types"`
+	Attempt     *int     `json:"attempt"`
+	MaxAttempts *int     `json:"max_attempts"`
+}
+
+// ParseLogLine parses a line logged by rly with the console or json log format,
+// which are the formats rly logs with when not attached to a terminal.
+func (commander) ParseLogLine(line string) (relayer.RelayerLogEvent, bool) {
+	l, ok := parseRlyLogLine(line)
+	if !ok {
+		return relayer.RelayerLogEvent{}, false
+	}
+
+	ev := relayer.RelayerLogEvent{
+		ChainID: l.ChainID,
+		Message: l.Message,
+		Line:    line,
+	}
+	if t, err := time.Parse(time.RFC3339Nano, l.Time); err == nil {
+		ev.Time = t
+	}
+
+	errMsg := l.Error
+	if errMsg == "" {
+		errMsg = l.RawLog
+	}
+	if errMsg != "" {
+		ev.Message += ": " + errMsg
+	}
+
+	switch {
+	case l.Message == "Successful transaction":
+		for _, typ := range l.MsgTypes {
+			if isPacketMsgType(typ) {
+				ev.Messages++
+			}
+		}
+		if ev.Messages == 0 {
+			return relayer.RelayerLogEvent{}, false
+		}
+		ev.Kind = relayer.LogEventPacketsRelayed
+	case errMsg != "" && l.Attempt != nil && l.MaxAttempts != nil && *l.Attempt < *l.MaxAttempts:
+		ev.Kind = relayer.LogEventRetry
+	case l.Level == "error", errMsg != "" && l.Level != "debug":
+		ev.Kind = relayer.LogEventError
+	default:
+		return relayer.RelayerLogEvent{}, false
+	}
+
+	if ev.Kind != relayer.LogEventPacketsRelayed {
+		ev.ErrorClass = relayer.ClassifyError(ev.Message)
+	}
+	return ev, true
+}
+
+// parseRlyLogLine parses a line of the console log format, whose fields are tab separated
+// and end with a JSON object of the structured fields, or of the json log format.
+func parseRlyLogLine(line string) (rlyLogLine, bool) {
+	var l rlyLogLine
+	if strings.HasPrefix(line, "{") {
+		var raw struct {
+			rlyLogLine
+			Ts  string `json:"ts"`
+			Lvl string `json:"lvl"`
+			Msg string `json:"msg"`
+		}
+		if err := json.Unmarshal([]byte(line), &raw); err != nil {
+			return l, false
+		}
+		l = raw.rlyLogLine
+		l.Time, l.Level, l.Message = raw.Ts, raw.Lvl, raw.Msg
+		return l, true
+	}
+
+	parts := strings.Split(line, "\t")
+	if len(parts) < 3 {
+		return l, false
+	}
+	if fields := parts[len(parts)-1]; strings.HasPrefix(fields, "{") {
+		if err := json.Unmarshal([]byte(fields), &l); err != nil {
+			return l, false
+		}
+		parts = parts[:len(parts)-1]
+	}
+	// The caller, if logged, sits between the level and the message.
+	l.Time, l.Level, l.Message = parts[0], parts[1], parts[len(parts)-1]
+	return l, true
+}
+
+func isPacketMsgType(typ string) bool {
+	for _, suffix := range []string{".MsgRecvPacket", ".MsgAcknowledgement", ".MsgTimeout", ".MsgTimeoutOnClose"} {
+		if strings.HasSuffix(typ, suffix) {
+			return true
+		}
+	}
+	return false
+}
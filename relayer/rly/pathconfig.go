@@ -0,0 +1,118 @@
+package rly
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/relayer"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	_ relayer.PathConfigCommander    = (*commander)(nil)
+	_ relayer.MetricsConfigCommander = (*commander)(nil)
+)
+
+// ConfigFilePath is the path of the config file created by rly config init.
+func (commander) ConfigFilePath() string {
+	return "config/config.yaml"
+}
+
+// ApplyPathConfig sets the channel filter of the path and the gas settings of its chains in the rly config file.
+func (commander) ApplyPathConfig(config []byte, pathName string, cfg ibc.RelayerPathConfig) ([]byte, error) {
+	var c map[string]any
+	if err := yaml.Unmarshal(config, &c); err != nil {
+		return nil, fmt.Errorf("parsing rly config: %w", err)
+	}
+
+	path, err := yamlMap(c, "paths", pathName)
+	if err != nil {
+		return nil, err
+	}
+	src, err := yamlMap(path, "src")
+	if err != nil {
+		return nil, err
+	}
+	dst, err := yamlMap(path, "dst")
+	if err != nil {
+		return nil, err
+	}
+	srcChainID, _ := src["chain-id"].(string)
+	dstChainID, _ := dst["chain-id"].(string)
+	if err := cfg.Validate(srcChainID, dstChainID); err != nil {
+		return nil, err
+	}
+
+	if cfg.Filter.Rule != "" {
+		channels := cfg.Filter.ChannelList
+		if channels == nil {
+			channels = []string{}
+		}
+		path["src-channel-filter"] = map[string]any{
+			"rule":         cfg.Filter.Rule,
+			"channel-list": channels,
+		}
+	}
+
+	for chainID, gas := range cfg.ChainGas {
+		value, err := yamlMap(c, "chains", chainID, "value")
+		if err != nil {
+			return nil, err
+		}
+		if gas.GasPrices != "" {
+			value["gas-prices"] = gas.GasPrices
+		}
+		if gas.GasAdjustment != 0 {
+			value["gas-adjustment"] = gas.GasAdjustment
+		}
+		if gas.MaxGas != 0 {
+			value["max-gas-amount"] = gas.MaxGas
+		}
+	}
+
+	return yaml.Marshal(c)
+}
+
+// ApplyMetricsConfig makes the api-listen-addr of the rly config file listen on all interfaces,
+// since rly config init binds it to 127.0.0.1, which cannot be reached through the published port.
+func (c commander) ApplyMetricsConfig(config []byte) ([]byte, error) {
+	var cfg map[string]any
+	if err := yaml.Unmarshal(config, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing rly config: %w", err)
+	}
+	global, err := yamlMap(cfg, "global")
+	if err != nil {
+		return nil, err
+	}
+	global["api-listen-addr"] = "0.0.0.0:" + c.MetricsPort()
+	return yaml.Marshal(cfg)
+}
+
+// PathStartFlags returns the flags of rly start for the clear interval, batch size and memo,
+// which are not part of the rly config file.
+func (commander) PathStartFlags(cfg ibc.RelayerPathConfig) []string {
+	var flags []string
+	if cfg.ClearInterval > 0 {
+		flags = append(flags, "--flush-interval", cfg.ClearInterval.String())
+	}
+	if cfg.MaxMsgsPerTx > 0 {
+		flags = append(flags, "--max-msgs", strconv.Itoa(cfg.MaxMsgsPerTx))
+	}
+	if cfg.Memo != "" {
+		flags = append(flags, "--memo", cfg.Memo)
+	}
+	return flags
+}
+
+// yamlMap returns the mapping found by following keys from m.
+func yamlMap(m map[string]any, keys ...string) (map[string]any, error) {
+	for i, key := range keys {
+		next, ok := m[key].(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%v not found in rly config", keys[:i+1])
+		}
+		m = next
+	}
+	return m, nil
+}
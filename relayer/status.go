@@ -0,0 +1,91 @@
+package relayer
+
+import (
+	"context"
+	"time"
+
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+)
+
+// APICommander is implemented by commanders of relayers which serve an HTTP API while started,
+// such as the hermes REST server. DockerRelayer publishes the port, see (*DockerRelayer).APIAddress.
+type APICommander interface {
+	// APIPort is the port of the API server inside the relayer container.
+	APIPort() string
+}
+
+// StatusReporter is implemented by relayers which report the health of their paths
+// and of the chain endpoints they are configured with.
+//
+// For instance, this waits for a started relayer to relay packets on a path:
+//
+//	if sr, ok := r.(relayer.StatusReporter); ok {
+//		require.Eventually(t, func() bool {
+//			status, err := sr.PathStatus(ctx, eRep, pathName)
+//			return err == nil && status.Relaying(time.Minute)
+//		}, 2*time.Minute, time.Second)
+//	}
+type StatusReporter interface {
+	// PathStatus reports whether the relayer is relaying pathName.
+	PathStatus(ctx context.Context, rep ibc.RelayerExecReporter, pathName string) (PathStatus, error)
+
+	// ChainEndpointStatus reports whether the RPC and gRPC endpoints the relayer uses for chainID are healthy.
+	ChainEndpointStatus(ctx context.Context, rep ibc.RelayerExecReporter, chainID string) (ChainEndpointStatus, error)
+}
+
+// PathStatus is the health of a path of a relayer.
+type PathStatus struct {
+	PathName string
+
+	// Running is true if the relayer is started and serving the path.
+	Running bool
+
+	// Workers are the tasks the relayer runs for the path, such as "Client" or "Packet", if the relayer reports them.
+	Workers []string
+
+	// PacketMessages is the number of packet messages the relayer logged as submitted to the chains of the path.
+	PacketMessages int
+
+	// LastPacket is when packet messages were last submitted to the chains of the path,
+	// or the zero time if none were.
+	LastPacket time.Time
+}
+
+// Relaying returns true if the path is running and packet messages were submitted within d.
+func (s PathStatus) Relaying(d time.Duration) bool {
+	return s.Running && !s.LastPacket.IsZero() && time.Since(s.LastPacket) <= d
+}
+
+// ChainEndpointStatus is the health of the endpoints of a chain, as seen by a relayer.
+type ChainEndpointStatus struct {
+	ChainID string
+
+	Healthy bool
+
+	// Reason the endpoints are unhealthy, if known.
+	Reason string
+}
+
+// PathActivity summarizes the LogEventPacketsRelayed events of chainIDs collected so far,
+// into the PacketMessages and LastPacket of a PathStatus.
+func (l *RelayerLogEvents) PathActivity(chainIDs ...string) (messages int, last time.Time) {
+	for _, ev := range l.Events() {
+		if ev.Kind != LogEventPacketsRelayed || !containsString(chainIDs, ev.ChainID) {
+			continue
+		}
+		messages += ev.Messages
+		if ev.Time.After(last) {
+			last = ev.Time
+		}
+	}
+	return messages, last
+}
+
+func containsString(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
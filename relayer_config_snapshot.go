@@ -0,0 +1,171 @@
+package interchaintest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/relayer"
+	"github.com/strangelove-ventures/interchaintest/v8/relayer/hermes"
+	"github.com/strangelove-ventures/interchaintest/v8/relayer/hyperspace"
+	"github.com/strangelove-ventures/interchaintest/v8/relayer/rly"
+)
+
+// RelayerConfigRenderer returns the relayer.ConfigRenderer of a built-in relayer implementation.
+func RelayerConfigRenderer(impl ibc.RelayerImplementation) (relayer.ConfigRenderer, error) {
+	switch impl {
+	case ibc.CosmosRly, ibc.CosmosRlyHost:
+		return rly.NewConfigRenderer(), nil
+	case ibc.Hermes:
+		return hermes.NewConfigRenderer(), nil
+	case ibc.Hyperspace:
+		return hyperspace.NewConfigRenderer(), nil
+	default:
+		return nil, fmt.Errorf("RelayerImplementation %v unknown", impl)
+	}
+}
+
+// RelayerConfigSnapshot renders the config files which Build would have the relayer named relayerName write,
+// for the chains and paths of its links, through AddChainConfiguration and GeneratePath.
+//
+// Neither the chains nor the relayer are started, nor even used beyond their config and name,
+// so placeholders such as a nil *rly.CosmosRelayer can be added as the relayer.
+// The chain addresses are derived from the chain IDs, so that the rendered files are stable.
+//
+// pathConfigs holds, by path name, the settings which the test applies with ConfigurePath, if any,
+// so that tuned configs can be snapshotted too.
+func (ic *Interchain) RelayerConfigSnapshot(relayerName string, renderer relayer.ConfigRenderer, pathConfigs map[string]ibc.RelayerPathConfig) ([]relayer.ConfigFile, error) {
+	var r ibc.Relayer
+	for rel, name := range ic.relayers {
+		if name == relayerName {
+			r = rel
+		}
+	}
+	if r == nil {
+		return nil, fmt.Errorf("relayer %s was never added to Interchain", relayerName)
+	}
+
+	var chains []relayer.ConfigChain
+	var paths []relayer.ConfigPath
+	for _, c := range ic.relayerChains()[r] {
+		chainID := c.Config().ChainID
+		chains = append(chains, relayer.ConfigChain{
+			Config:   c.Config(),
+			KeyName:  ic.chains[c],
+			RPCAddr:  fmt.Sprintf("http://%s:26657", chainID),
+			GRPCAddr: fmt.Sprintf("%s:9090", chainID),
+		})
+	}
+	for rp, link := range ic.links {
+		if rp.Relayer != r {
+			continue
+		}
+		paths = append(paths, relayer.ConfigPath{
+			Name:       rp.Path,
+			SrcChainID: link.chains[0].Config().ChainID,
+			DstChainID: link.chains[1].Config().ChainID,
+			Config:     pathConfigs[rp.Path],
+		})
+	}
+	sort.Slice(chains, func(i, j int) bool { return chains[i].Config.ChainID < chains[j].Config.ChainID })
+	sort.Slice(paths, func(i, j int) bool { return paths[i].Name < paths[j].Name })
+
+	return renderer.RenderConfig(chains, paths)
+}
+
+// CompareGoldenFiles compares files with the golden files at the same paths in dir,
+// returning an error which describes every difference, including golden files which were not rendered.
+// If update is true, the golden files in dir are replaced with files instead.
+//
+// Tests typically take update from a flag:
+//
+//	var update = flag.Bool("update-golden", false, "update golden files")
+//
+//	files, err := ic.RelayerConfigSnapshot("rly", rly.NewConfigRenderer(), nil)
+//	require.NoError(t, err)
+//	require.NoError(t, interchaintest.CompareGoldenFiles("testdata/rly", files, *update))
+func CompareGoldenFiles(dir string, files []relayer.ConfigFile, update bool) error {
+	if update {
+		if err := os.RemoveAll(dir); err != nil {
+			return err
+		}
+		for _, f := range files {
+			p := filepath.Join(dir, filepath.FromSlash(f.Path))
+			if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+				return err
+			}
+			if err := os.WriteFile(p, f.Content, 0o644); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var diffs []string
+	rendered := make(map[string]bool, len(files))
+	for _, f := range files {
+		rendered[filepath.FromSlash(f.Path)] = true
+
+		want, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(f.Path)))
+		if errors.Is(err, fs.ErrNotExist) {
+			diffs = append(diffs, fmt.Sprintf("%s: no golden file", f.Path))
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if diff := firstLineDiff(want, f.Content); diff != "" {
+			diffs = append(diffs, fmt.Sprintf("%s: %s", f.Path, diff))
+		}
+	}
+
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		if !rendered[rel] {
+			diffs = append(diffs, fmt.Sprintf("%s: golden file was not rendered", filepath.ToSlash(rel)))
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	if len(diffs) > 0 {
+		sort.Strings(diffs)
+		return fmt.Errorf("files differ from golden files in %s (rerun with update to accept):\n  %s", dir, strings.Join(diffs, "\n  "))
+	}
+	return nil
+}
+
+// firstLineDiff describes the first line which differs between want and got, if any.
+func firstLineDiff(want, got []byte) string {
+	if bytes.Equal(want, got) {
+		return ""
+	}
+	wantLines := strings.Split(string(want), "\n")
+	gotLines := strings.Split(string(got), "\n")
+	for i := 0; ; i++ {
+		var w, g string
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if w != g || i >= len(wantLines) || i >= len(gotLines) {
+			return fmt.Sprintf("line %d: want %q, got %q", i+1, w, g)
+		}
+	}
+}
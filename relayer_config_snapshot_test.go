@@ -0,0 +1,98 @@
+package interchaintest_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/relayer"
+	"github.com/strangelove-ventures/interchaintest/v8/relayer/rly"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+var updateGolden = flag.Bool("update-golden", false, "update the golden files of the relayer config snapshots")
+
+func TestRelayerConfigSnapshot(t *testing.T) {
+	cf := interchaintest.NewBuiltinChainFactory(zaptest.NewLogger(t), []*interchaintest.ChainSpec{
+		{Name: "gaia", ChainName: "gaia", Version: "v15.1.0", ChainConfig: ibc.ChainConfig{ChainID: "gaia-1"}},
+		{Name: "osmosis", ChainName: "osmosis", Version: "v24.0.1", ChainConfig: ibc.ChainConfig{ChainID: "osmosis-1"}},
+	})
+	chains, err := cf.Chains(t.Name())
+	require.NoError(t, err)
+
+	// The relayer is only a placeholder; nothing is started.
+	var r *rly.CosmosRelayer
+	ic := interchaintest.NewInterchain().
+		AddChain(chains[0]).
+		AddChain(chains[1]).
+		AddRelayer(r, "relayer").
+		AddLink(interchaintest.InterchainLink{
+			Chain1:  chains[0],
+			Chain2:  chains[1],
+			Relayer: r,
+			Path:    "gaia-osmosis",
+		})
+
+	for _, impl := range []struct {
+		name string
+		impl ibc.RelayerImplementation
+	}{
+		{"rly", ibc.CosmosRly},
+		{"hermes", ibc.Hermes},
+		{"hyperspace", ibc.Hyperspace},
+	} {
+		t.Run(impl.name, func(t *testing.T) {
+			renderer, err := interchaintest.RelayerConfigRenderer(impl.impl)
+			require.NoError(t, err)
+
+			files, err := ic.RelayerConfigSnapshot("relayer", renderer, map[string]ibc.RelayerPathConfig{
+				"gaia-osmosis": {
+					Filter:        ibc.ChannelFilter{Rule: ibc.ChannelFilterAllowlist, ChannelList: []string{"channel-0"}},
+					ClearInterval: time.Minute,
+					MaxMsgsPerTx:  10,
+				},
+			})
+			require.NoError(t, err)
+			require.NotEmpty(t, files)
+
+			require.NoError(t, interchaintest.CompareGoldenFiles(filepath.Join("testdata", "relayer-configs", impl.name), files, *updateGolden))
+		})
+	}
+
+	_, err = ic.RelayerConfigSnapshot("other", rly.NewConfigRenderer(), nil)
+	require.Error(t, err)
+}
+
+func TestCompareGoldenFiles(t *testing.T) {
+	dir := t.TempDir()
+	files := []relayer.ConfigFile{
+		{Path: "a.config", Content: []byte("a = 1\nb = 2\n")},
+		{Path: "paths/p.json", Content: []byte("{}\n")},
+	}
+	require.NoError(t, interchaintest.CompareGoldenFiles(dir, files, true))
+	require.NoError(t, interchaintest.CompareGoldenFiles(dir, files, false))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "stale.config"), nil, 0o644))
+	changed := []relayer.ConfigFile{
+		{Path: "a.config", Content: []byte("a = 1\nb = 3\n")},
+		{Path: "new.config", Content: []byte("c = 1\n")},
+	}
+	err := interchaintest.CompareGoldenFiles(dir, changed, false)
+	require.Error(t, err)
+	for _, want := range []string{
+		`a.config: line 2: want "b = 2", got "b = 3"`,
+		"new.config: no golden file",
+		"paths/p.json: golden file was not rendered",
+		"stale.config: golden file was not rendered",
+	} {
+		require.ErrorContains(t, err, want)
+	}
+
+	require.NoError(t, interchaintest.CompareGoldenFiles(dir, changed, true))
+	require.NoError(t, interchaintest.CompareGoldenFiles(dir, changed, false))
+}
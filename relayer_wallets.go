@@ -0,0 +1,379 @@
+package interchaintest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"go.uber.org/zap"
+)
+
+// RelayerWallet is the wallet of a relayer on a chain, created and funded at genesis during Build.
+type RelayerWallet struct {
+	Relayer ibc.Relayer
+
+	// Name the relayer was added to the Interchain with.
+	RelayerName string
+
+	Chain  ibc.Chain
+	Wallet ibc.Wallet
+}
+
+// RelayerWallets returns the wallets created for the relayers during Build,
+// sorted by relayer name and chain ID.
+func (ic *Interchain) RelayerWallets() []RelayerWallet {
+	wallets := make([]RelayerWallet, 0, len(ic.relayerWallets))
+	for rc, w := range ic.relayerWallets {
+		wallets = append(wallets, RelayerWallet{
+			Relayer:     rc.R,
+			RelayerName: ic.relayers[rc.R],
+			Chain:       rc.C,
+			Wallet:      w,
+		})
+	}
+	sort.Slice(wallets, func(i, j int) bool {
+		if wallets[i].RelayerName != wallets[j].RelayerName {
+			return wallets[i].RelayerName < wallets[j].RelayerName
+		}
+		return ic.chains[wallets[i].Chain] < ic.chains[wallets[j].Chain]
+	})
+	return wallets
+}
+
+// RelayerBalance is the balance of a relayer wallet in the denom of its chain, which pays the fees.
+type RelayerBalance struct {
+	RelayerWallet
+
+	Amount math.Int
+}
+
+// RelayerBalances queries the balance of every relayer wallet.
+func (ic *Interchain) RelayerBalances(ctx context.Context) ([]RelayerBalance, error) {
+	return queryRelayerBalances(ctx, ic.RelayerWallets())
+}
+
+func queryRelayerBalances(ctx context.Context, wallets []RelayerWallet) ([]RelayerBalance, error) {
+	balances := make([]RelayerBalance, len(wallets))
+	for i, w := range wallets {
+		amount, err := w.Chain.GetBalance(ctx, w.Wallet.FormattedAddress(), w.Chain.Config().Denom)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query balance of relayer %s on chain %s: %w", w.RelayerName, w.Chain.Config().ChainID, err)
+		}
+		balances[i] = RelayerBalance{RelayerWallet: w, Amount: amount}
+	}
+	return balances, nil
+}
+
+// RelayerFeesSpent returns how much the balance of each wallet of after decreased since before.
+// It assumes the wallets only spent funds on fees in between, as a relayer wallet does.
+// Wallets missing from before are omitted.
+func RelayerFeesSpent(before, after []RelayerBalance) []RelayerBalance {
+	initial := make(map[relayerChain]math.Int, len(before))
+	for _, b := range before {
+		initial[relayerChain{R: b.Relayer, C: b.Chain}] = b.Amount
+	}
+
+	var spent []RelayerBalance
+	for _, a := range after {
+		amount, ok := initial[relayerChain{R: a.Relayer, C: a.Chain}]
+		if !ok {
+			continue
+		}
+		spent = append(spent, RelayerBalance{RelayerWallet: a.RelayerWallet, Amount: amount.Sub(a.Amount)})
+	}
+	return spent
+}
+
+// DefaultRelayerWalletMonitorInterval is how often a RelayerWalletMonitor queries the balances by default.
+const DefaultRelayerWalletMonitorInterval = 10 * time.Second
+
+// RelayerWalletMonitorOptions configures (*Interchain).MonitorRelayerWallets.
+type RelayerWalletMonitorOptions struct {
+	// How often the balances are queried.
+	// Defaults to DefaultRelayerWalletMonitorInterval.
+	Interval time.Duration
+
+	// Balance below which a relayer wallet is low, in the denom of its chain.
+	Threshold math.Int
+
+	// Thresholds overriding Threshold for the chains with the given IDs.
+	ChainThresholds map[string]math.Int
+
+	// If set, a low wallet is sent this amount from the faucet account of its chain.
+	TopUpAmount math.Int
+
+	// If set, Stop returns an error if any wallet was low, even if it was topped up.
+	FailOnLowBalance bool
+
+	// If set, called with every event, from the goroutine monitoring the wallets.
+	OnEvent func(RelayerWalletEvent)
+}
+
+// RelayerWalletEventType is the type of a RelayerWalletEvent.
+type RelayerWalletEventType string
+
+const (
+	// RelayerWalletLow is emitted when the balance of a wallet falls below its threshold.
+	// It is not emitted again for the wallet until the balance recovers or the wallet is topped up.
+	RelayerWalletLow RelayerWalletEventType = "low"
+
+	// RelayerWalletToppedUp is emitted when a low wallet was sent funds from the faucet.
+	RelayerWalletToppedUp RelayerWalletEventType = "topped_up"
+
+	// RelayerWalletTopUpFailed is emitted when sending funds to a low wallet failed.
+	RelayerWalletTopUpFailed RelayerWalletEventType = "top_up_failed"
+)
+
+// RelayerWalletEvent is a change of the state of a relayer wallet observed by a RelayerWalletMonitor.
+type RelayerWalletEvent struct {
+	Time time.Time
+	Type RelayerWalletEventType
+
+	RelayerWallet
+
+	Balance   math.Int
+	Threshold math.Int
+
+	// Amount sent from the faucet, for RelayerWalletToppedUp events.
+	Amount math.Int
+
+	// Error sending from the faucet, for RelayerWalletTopUpFailed events.
+	Err error
+}
+
+// RelayerWalletMonitor tracks the balances of the relayer wallets of an Interchain,
+// reporting wallets falling below a threshold and optionally topping them up from the faucet.
+type RelayerWalletMonitor struct {
+	log  *zap.Logger
+	opts RelayerWalletMonitorOptions
+
+	wallets []RelayerWallet
+	initial []RelayerBalance
+
+	mu       sync.Mutex
+	events   []RelayerWalletEvent
+	low      map[relayerChain]bool
+	toppedUp map[relayerChain]math.Int
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// MonitorRelayerWallets starts monitoring the relayer wallets, until Stop is called on the returned monitor.
+// It must be called after Build.
+//
+// Long-running tests and sessions should monitor the wallets,
+// since relayers quietly stop relaying once their wallets cannot pay fees anymore.
+func (ic *Interchain) MonitorRelayerWallets(ctx context.Context, opts RelayerWalletMonitorOptions) (*RelayerWalletMonitor, error) {
+	if !ic.built {
+		return nil, errors.New("MonitorRelayerWallets must be called after Build")
+	}
+	if opts.Threshold.IsNil() {
+		return nil, errors.New("relayer wallet monitor threshold must be set")
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultRelayerWalletMonitorInterval
+	}
+
+	m := &RelayerWalletMonitor{
+		log:      ic.log,
+		opts:     opts,
+		wallets:  ic.RelayerWallets(),
+		low:      make(map[relayerChain]bool),
+		toppedUp: make(map[relayerChain]math.Int),
+	}
+
+	initial, err := queryRelayerBalances(ctx, m.wallets)
+	if err != nil {
+		return nil, err
+	}
+	m.initial = initial
+	m.check(ctx, initial)
+
+	ctx, m.cancel = context.WithCancel(ctx)
+	m.done = make(chan struct{})
+	go m.run(ctx)
+
+	return m, nil
+}
+
+func (m *RelayerWalletMonitor) run(ctx context.Context) {
+	defer close(m.done)
+
+	ticker := time.NewTicker(m.opts.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := m.Check(ctx); err != nil && ctx.Err() == nil {
+			m.log.Info("Failed to check relayer wallet balances", zap.Error(err))
+		}
+	}
+}
+
+// Check queries the balances and handles low wallets immediately,
+// instead of waiting for the next periodic check.
+func (m *RelayerWalletMonitor) Check(ctx context.Context) error {
+	balances, err := queryRelayerBalances(ctx, m.wallets)
+	if err != nil {
+		return err
+	}
+	m.check(ctx, balances)
+	return nil
+}
+
+func (m *RelayerWalletMonitor) check(ctx context.Context, balances []RelayerBalance) {
+	for _, b := range balances {
+		key := relayerChain{R: b.Relayer, C: b.Chain}
+		threshold := m.threshold(b.Chain.Config().ChainID)
+
+		m.mu.Lock()
+		wasLow := m.low[key]
+		m.low[key] = b.Amount.LT(threshold)
+		m.mu.Unlock()
+
+		if !b.Amount.LT(threshold) || wasLow {
+			continue
+		}
+
+		m.emit(RelayerWalletEvent{
+			Type:          RelayerWalletLow,
+			RelayerWallet: b.RelayerWallet,
+			Balance:       b.Amount,
+			Threshold:     threshold,
+		})
+
+		if m.opts.TopUpAmount.IsNil() || !m.opts.TopUpAmount.IsPositive() {
+			continue
+		}
+		m.topUp(ctx, b, threshold)
+	}
+}
+
+func (m *RelayerWalletMonitor) topUp(ctx context.Context, b RelayerBalance, threshold math.Int) {
+	amount := m.opts.TopUpAmount
+	err := b.Chain.SendFunds(ctx, FaucetAccountKeyName, ibc.WalletAmount{
+		Address: b.Wallet.FormattedAddress(),
+		Denom:   b.Chain.Config().Denom,
+		Amount:  amount,
+	})
+	if err != nil {
+		m.emit(RelayerWalletEvent{
+			Type:          RelayerWalletTopUpFailed,
+			RelayerWallet: b.RelayerWallet,
+			Balance:       b.Amount,
+			Threshold:     threshold,
+			Err:           err,
+		})
+		return
+	}
+
+	key := relayerChain{R: b.Relayer, C: b.Chain}
+	m.mu.Lock()
+	if sent, ok := m.toppedUp[key]; ok {
+		m.toppedUp[key] = sent.Add(amount)
+	} else {
+		m.toppedUp[key] = amount
+	}
+	// The next check tops the wallet up again if it is still low.
+	delete(m.low, key)
+	m.mu.Unlock()
+
+	m.emit(RelayerWalletEvent{
+		Type:          RelayerWalletToppedUp,
+		RelayerWallet: b.RelayerWallet,
+		Balance:       b.Amount,
+		Threshold:     threshold,
+		Amount:        amount,
+	})
+}
+
+func (m *RelayerWalletMonitor) threshold(chainID string) math.Int {
+	if t, ok := m.opts.ChainThresholds[chainID]; ok {
+		return t
+	}
+	return m.opts.Threshold
+}
+
+func (m *RelayerWalletMonitor) emit(ev RelayerWalletEvent) {
+	ev.Time = time.Now()
+
+	m.mu.Lock()
+	m.events = append(m.events, ev)
+	m.mu.Unlock()
+
+	m.log.Info(
+		"Relayer wallet balance event",
+		zap.String("event", string(ev.Type)),
+		zap.String("relayer", ev.RelayerName),
+		zap.String("chain_id", ev.Chain.Config().ChainID),
+		zap.String("balance", ev.Balance.String()),
+		zap.String("threshold", ev.Threshold.String()),
+		zap.Error(ev.Err),
+	)
+
+	if m.opts.OnEvent != nil {
+		m.opts.OnEvent(ev)
+	}
+}
+
+// Events returns the events emitted so far.
+func (m *RelayerWalletMonitor) Events() []RelayerWalletEvent {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]RelayerWalletEvent(nil), m.events...)
+}
+
+// FeesSpent returns how much each relayer wallet spent since monitoring started,
+// accounting for the funds the monitor topped the wallet up with.
+// It is useful for tracking regressions in the cost of relaying.
+func (m *RelayerWalletMonitor) FeesSpent(ctx context.Context) ([]RelayerBalance, error) {
+	current, err := queryRelayerBalances(ctx, m.wallets)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	before := make([]RelayerBalance, len(m.initial))
+	for i, b := range m.initial {
+		if sent, ok := m.toppedUp[relayerChain{R: b.Relayer, C: b.Chain}]; ok {
+			b.Amount = b.Amount.Add(sent)
+		}
+		before[i] = b
+	}
+	m.mu.Unlock()
+
+	return RelayerFeesSpent(before, current), nil
+}
+
+// Stop stops monitoring the wallets.
+// If the monitor was configured with FailOnLowBalance, it returns an error naming the wallets which were low.
+func (m *RelayerWalletMonitor) Stop() error {
+	m.cancel()
+	<-m.done
+
+	if !m.opts.FailOnLowBalance {
+		return nil
+	}
+
+	var low []string
+	for _, ev := range m.Events() {
+		if ev.Type == RelayerWalletLow {
+			low = append(low, fmt.Sprintf("%s on %s (%s%s)", ev.RelayerName, ev.Chain.Config().ChainID, ev.Balance, ev.Chain.Config().Denom))
+		}
+	}
+	if len(low) > 0 {
+		return fmt.Errorf("relayer wallet balances fell below the threshold: %s", strings.Join(low, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package interchaintest
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// balanceChain is a chain which only holds balances, and funds sends from the faucet.
+type balanceChain struct {
+	ibc.Chain
+
+	chainID string
+
+	mu       sync.Mutex
+	balances map[string]math.Int
+}
+
+func (c *balanceChain) Config() ibc.ChainConfig {
+	return ibc.ChainConfig{ChainID: c.chainID, Denom: "stake"}
+}
+
+func (c *balanceChain) GetBalance(ctx context.Context, address, denom string) (math.Int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.balances[address], nil
+}
+
+func (c *balanceChain) SendFunds(ctx context.Context, keyName string, amount ibc.WalletAmount) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.balances[amount.Address] = c.balances[amount.Address].Add(amount.Amount)
+	return nil
+}
+
+func (c *balanceChain) setBalance(address string, amount int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.balances[address] = math.NewInt(amount)
+}
+
+type addressWallet string
+
+func (w addressWallet) KeyName() string          { return "" }
+func (w addressWallet) FormattedAddress() string { return string(w) }
+func (w addressWallet) Mnemonic() string         { return "" }
+func (w addressWallet) Address() []byte          { return []byte(w) }
+
+// namedRelayer only tells relayers apart.
+type namedRelayer struct {
+	ibc.Relayer
+	name string
+}
+
+func TestRelayerWalletMonitor(t *testing.T) {
+	ctx := context.Background()
+
+	c0 := &balanceChain{chainID: "chain-0", balances: map[string]math.Int{"r-0": math.NewInt(1000)}}
+	c1 := &balanceChain{chainID: "chain-1", balances: map[string]math.Int{"r-1": math.NewInt(1000)}}
+	r := &namedRelayer{name: "r"}
+
+	ic := &Interchain{
+		log:      zap.NewNop(),
+		chains:   map[ibc.Chain]string{c0: "chain-0", c1: "chain-1"},
+		relayers: map[ibc.Relayer]string{r: "r"},
+		built:    true,
+		relayerWallets: map[relayerChain]ibc.Wallet{
+			{R: r, C: c0}: addressWallet("r-0"),
+			{R: r, C: c1}: addressWallet("r-1"),
+		},
+	}
+
+	wallets := ic.RelayerWallets()
+	require.Len(t, wallets, 2)
+	require.Equal(t, c0, wallets[0].Chain)
+	require.Equal(t, "r", wallets[0].RelayerName)
+
+	_, err := ic.MonitorRelayerWallets(ctx, RelayerWalletMonitorOptions{})
+	require.Error(t, err, "threshold is required")
+
+	var events []RelayerWalletEvent
+	m, err := ic.MonitorRelayerWallets(ctx, RelayerWalletMonitorOptions{
+		Interval:         time.Hour,
+		Threshold:        math.NewInt(500),
+		ChainThresholds:  map[string]math.Int{"chain-1": math.NewInt(100)},
+		TopUpAmount:      math.NewInt(1000),
+		FailOnLowBalance: true,
+		OnEvent:          func(ev RelayerWalletEvent) { events = append(events, ev) },
+	})
+	require.NoError(t, err)
+	require.Empty(t, m.Events())
+
+	// Spending fees below the chain threshold of chain-1 is fine.
+	c0.setBalance("r-0", 400)
+	c1.setBalance("r-1", 200)
+	require.NoError(t, m.Check(ctx))
+
+	require.Len(t, events, 2)
+	require.Equal(t, RelayerWalletLow, events[0].Type)
+	require.Equal(t, c0, events[0].Chain)
+	require.Equal(t, int64(400), events[0].Balance.Int64())
+	require.Equal(t, int64(500), events[0].Threshold.Int64())
+	require.Equal(t, RelayerWalletToppedUp, events[1].Type)
+	require.Equal(t, int64(1000), events[1].Amount.Int64())
+	require.Equal(t, events, m.Events())
+
+	balances, err := ic.RelayerBalances(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1400), balances[0].Amount.Int64())
+
+	// The top up is not counted as spent.
+	spent, err := m.FeesSpent(ctx)
+	require.NoError(t, err)
+	require.Len(t, spent, 2)
+	require.Equal(t, int64(600), spent[0].Amount.Int64())
+	require.Equal(t, int64(800), spent[1].Amount.Int64())
+
+	require.NoError(t, m.Check(ctx))
+	require.Len(t, m.Events(), 2, "topped up wallet is not low anymore")
+
+	require.ErrorContains(t, m.Stop(), "r on chain-0 (400stake)")
+}
+
+func TestRelayerFeesSpent(t *testing.T) {
+	c := &balanceChain{chainID: "chain-0"}
+	r0, r1 := &namedRelayer{name: "r0"}, &namedRelayer{name: "r1"}
+
+	before := []RelayerBalance{
+		{RelayerWallet: RelayerWallet{Relayer: r0, Chain: c}, Amount: math.NewInt(100)},
+	}
+	after := []RelayerBalance{
+		{RelayerWallet: RelayerWallet{Relayer: r0, Chain: c}, Amount: math.NewInt(70)},
+		{RelayerWallet: RelayerWallet{Relayer: r1, Chain: c}, Amount: math.NewInt(10)},
+	}
+
+	spent := RelayerFeesSpent(before, after)
+	require.Len(t, spent, 1)
+	require.Equal(t, r0, spent[0].Relayer)
+	require.Equal(t, int64(30), spent[0].Amount.Int64())
+}
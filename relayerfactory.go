@@ -1,7 +1,9 @@
 package interchaintest
 
 import (
+	"context"
 	"fmt"
+	"os"
 
 	"github.com/docker/docker/client"
 	"github.com/strangelove-ventures/interchaintest/v8/ibc"
@@ -41,12 +43,22 @@ type builtinRelayerFactory struct {
 	log     *zap.Logger
 	options []relayer.RelayerOpt
 	version string
+
+	// Path to the relayer binary of host process implementations.
+	binary string
 }
 
 func NewBuiltinRelayerFactory(impl ibc.RelayerImplementation, logger *zap.Logger, options ...relayer.RelayerOpt) RelayerFactory {
 	return &builtinRelayerFactory{impl: impl, log: logger, options: options}
 }
 
+// NewHostRelayerFactory returns a RelayerFactory for ibc.CosmosRlyHost,
+// running the rly binary at binaryPath, e.g. one built from a local checkout.
+// Using NewBuiltinRelayerFactory with ibc.CosmosRlyHost instead runs the rly binary found in PATH.
+func NewHostRelayerFactory(logger *zap.Logger, binaryPath string) RelayerFactory {
+	return &builtinRelayerFactory{impl: ibc.CosmosRlyHost, log: logger, binary: binaryPath}
+}
+
 // Build returns a relayer chosen depending on f.impl.
 func (f *builtinRelayerFactory) Build(
 	t TestName,
@@ -76,11 +88,34 @@ func (f *builtinRelayerFactory) Build(
 		r := hermes.NewHermesRelayer(f.log, t.Name(), cli, networkID, f.options...)
 		f.setRelayerVersion(r.ContainerImage())
 		return r
+	case ibc.CosmosRlyHost:
+		binary := f.binary
+		if binary == "" {
+			binary = "rly"
+		}
+		r, err := rly.NewHostRelayer(context.Background(), f.log, t.Name(), binary, hostRelayerHomeDir(t))
+		if err != nil {
+			panic(err)
+		}
+		return r
 	default:
 		panic(fmt.Errorf("RelayerImplementation %v unknown", f.impl))
 	}
 }
 
+// hostRelayerHomeDir returns a temporary home directory for a host relayer,
+// which is removed at the end of the test if t supports cleanup.
+func hostRelayerHomeDir(t TestName) string {
+	if tt, ok := t.(TempDirTestingT); ok {
+		return TempDir(tt)
+	}
+	dir, err := os.MkdirTemp("", "rly-"+sanitizeTestName(t.Name()))
+	if err != nil {
+		panic(fmt.Errorf("creating relayer home directory: %w", err))
+	}
+	return dir
+}
+
 func (f *builtinRelayerFactory) setRelayerVersion(di ibc.DockerImage) {
 	f.version = di.Version
 }
@@ -97,6 +132,8 @@ func (f *builtinRelayerFactory) Name() string {
 			return "hermes@" + f.version
 		}
 		return "hermes@" + hermes.DefaultContainerVersion
+	case ibc.CosmosRlyHost:
+		return "rly@host"
 	default:
 		panic(fmt.Errorf("RelayerImplementation %v unknown", f.impl))
 	}
@@ -106,11 +143,10 @@ func (f *builtinRelayerFactory) Name() string {
 // relayer implementation backing this factory.
 func (f builtinRelayerFactory) Capabilities() map[relayer.Capability]bool {
 	switch f.impl {
-	case ibc.CosmosRly:
+	case ibc.CosmosRly, ibc.CosmosRlyHost:
 		return rly.Capabilities()
 	case ibc.Hermes:
-		// TODO: specify capability for hermes.
-		return rly.Capabilities()
+		return hermes.Capabilities()
 	default:
 		panic(fmt.Errorf("RelayerImplementation %v unknown", f.impl))
 	}
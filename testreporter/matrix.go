@@ -0,0 +1,253 @@
+package testreporter
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ReadMessages decodes the messages written by a Reporter, one JSON object per line.
+func ReadMessages(r io.Reader) ([]Message, error) {
+	var msgs []Message
+
+	dec := json.NewDecoder(bufio.NewReader(r))
+	for {
+		var m WrappedMessage
+		if err := dec.Decode(&m); err != nil {
+			if err == io.EOF {
+				return msgs, nil
+			}
+			return msgs, fmt.Errorf("failed to decode message %d: %w", len(msgs)+1, err)
+		}
+		msgs = append(msgs, m.Message)
+	}
+}
+
+// TestStatus is the outcome of a test in a CompatibilityMatrix.
+type TestStatus string
+
+const (
+	TestPassed  TestStatus = "pass"
+	TestFailed  TestStatus = "fail"
+	TestSkipped TestStatus = "skip"
+)
+
+// TestResult is the outcome of one test case, for one relayer and chain pair.
+type TestResult struct {
+	// Full name of the test.
+	Name string
+
+	Status TestStatus
+
+	// Time the test spent running, excluding time paused waiting for parallel execution.
+	Duration time.Duration
+
+	// Reason given through TrackSkip, if the test was skipped.
+	SkipReason string
+
+	// Capabilities the relayer lacked, if that is why the test was skipped.
+	MissingCapabilities []string
+
+	// Failed assertions tracked for the test, if it failed.
+	Errors []string
+
+	// Relayer commands which failed during the test or its parent tests, if the test failed.
+	FailedExecs []RelayerExecMessage
+}
+
+// CompatibilityMatrix holds the results of the conformance tests of a report,
+// by relayer, chain pair and test case.
+//
+// Tests are placed in the matrix according to the names given to them by the conformance package,
+// "<root>/chain_pairs/<chain pair>/<relayer>/<test case>".
+// Only tests without tracked subtests are included as test cases.
+type CompatibilityMatrix struct {
+	// When the reported test suite started.
+	StartedAt time.Time
+
+	Relayers   []string
+	ChainPairs []string
+	TestCases  []string
+
+	results map[matrixKey]*TestResult
+}
+
+type matrixKey struct {
+	chainPair, relayer, testCase string
+}
+
+// chainPairsTestName is the name of the conformance test grouping the tests of all chain pairs.
+const chainPairsTestName = "chain_pairs"
+
+// missingCapabilitiesRe matches the skip reason of conformance tests which require an unsupported relayer capability.
+var missingCapabilitiesRe = regexp.MustCompile(`missing relayer capabilities \+\[([^\]]*)\]`)
+
+// NewCompatibilityMatrix builds the compatibility matrix from the messages of a report, e.g. from ReadMessages.
+func NewCompatibilityMatrix(msgs []Message) *CompatibilityMatrix {
+	var (
+		begins   = make(map[string]BeginTestMessage)
+		finishes = make(map[string]FinishTestMessage)
+		paused   = make(map[string]time.Duration)
+		pauses   = make(map[string]time.Time)
+		skips    = make(map[string]string)
+		errs     = make(map[string][]string)
+		execs    []RelayerExecMessage
+
+		startedAt time.Time
+	)
+	for _, msg := range msgs {
+		switch m := msg.(type) {
+		case BeginSuiteMessage:
+			startedAt = m.StartedAt
+		case BeginTestMessage:
+			begins[m.Name] = m
+		case FinishTestMessage:
+			finishes[m.Name] = m
+		case PauseTestMessage:
+			pauses[m.Name] = m.When
+		case ContinueTestMessage:
+			if when, ok := pauses[m.Name]; ok {
+				paused[m.Name] += m.When.Sub(when)
+				delete(pauses, m.Name)
+			}
+		case TestSkipMessage:
+			skips[m.Name] = m.Message
+		case TestErrorMessage:
+			errs[m.Name] = append(errs[m.Name], m.Message)
+		case RelayerExecMessage:
+			execs = append(execs, m)
+		}
+	}
+
+	m := &CompatibilityMatrix{
+		StartedAt: startedAt,
+		results:   make(map[matrixKey]*TestResult),
+	}
+	relayers, chainPairs, testCases := make(map[string]bool), make(map[string]bool), make(map[string]bool)
+
+	for name, begin := range begins {
+		if hasTrackedSubtest(begins, name) {
+			continue
+		}
+		key, ok := parseMatrixTestName(begins, name)
+		if !ok {
+			continue
+		}
+
+		res := &TestResult{Name: name}
+		finish, finished := finishes[name]
+		if finished {
+			res.Duration = finish.FinishedAt.Sub(begin.StartedAt) - paused[name]
+		}
+		switch {
+		case !finished:
+			res.Status = TestFailed
+			res.Errors = append(res.Errors, "test did not finish")
+		case finish.Skipped:
+			res.Status = TestSkipped
+		case finish.Failed:
+			res.Status = TestFailed
+		default:
+			res.Status = TestPassed
+		}
+
+		if res.Status == TestSkipped {
+			res.SkipReason = skips[name]
+			if match := missingCapabilitiesRe.FindStringSubmatch(res.SkipReason); match != nil {
+				res.MissingCapabilities = strings.Fields(match[1])
+			}
+		}
+
+		if res.Status == TestFailed {
+			res.Errors = append(res.Errors, errs[name]...)
+			for _, exec := range execs {
+				if (exec.ExitCode != 0 || exec.Error != "") && (exec.Name == name || strings.HasPrefix(name, exec.Name+"/")) {
+					res.FailedExecs = append(res.FailedExecs, exec)
+				}
+			}
+			sort.SliceStable(res.FailedExecs, func(i, j int) bool {
+				return res.FailedExecs[i].StartedAt.Before(res.FailedExecs[j].StartedAt)
+			})
+		}
+
+		m.results[key] = res
+		relayers[key.relayer] = true
+		chainPairs[key.chainPair] = true
+		testCases[key.testCase] = true
+	}
+
+	m.Relayers = sortedKeys(relayers)
+	m.ChainPairs = sortedKeys(chainPairs)
+	m.TestCases = sortedKeys(testCases)
+	return m
+}
+
+// Result returns the result of testCase for relayer and chainPair,
+// or false if the test case did not run for that combination.
+func (m *CompatibilityMatrix) Result(chainPair, relayer, testCase string) (*TestResult, bool) {
+	res, ok := m.results[matrixKey{chainPair: chainPair, relayer: relayer, testCase: testCase}]
+	return res, ok
+}
+
+// Counts returns the number of test cases run for relayer across all chain pairs, by status.
+func (m *CompatibilityMatrix) Counts(relayer string) map[TestStatus]int {
+	counts := make(map[TestStatus]int)
+	for key, res := range m.results {
+		if key.relayer == relayer {
+			counts[res.Status]++
+		}
+	}
+	return counts
+}
+
+// parseMatrixTestName places the test name in the matrix.
+// The test case is named after the tracked tests below the relayer test,
+// omitting untracked intermediate subtests.
+func parseMatrixTestName(begins map[string]BeginTestMessage, name string) (matrixKey, bool) {
+	parts := strings.Split(name, "/")
+
+	i := 0
+	for i < len(parts) && parts[i] != chainPairsTestName {
+		i++
+	}
+	// Chain pair, relayer and at least one test case level must follow.
+	if len(parts)-i < 4 {
+		return matrixKey{}, false
+	}
+
+	var testCase []string
+	for j := i + 3; j < len(parts); j++ {
+		if _, tracked := begins[strings.Join(parts[:j+1], "/")]; tracked {
+			testCase = append(testCase, parts[j])
+		}
+	}
+
+	return matrixKey{
+		chainPair: parts[i+1],
+		relayer:   parts[i+2],
+		testCase:  strings.Join(testCase, "/"),
+	}, true
+}
+
+func hasTrackedSubtest(begins map[string]BeginTestMessage, name string) bool {
+	for other := range begins {
+		if strings.HasPrefix(other, name+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
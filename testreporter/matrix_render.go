@@ -0,0 +1,224 @@
+package testreporter
+
+import (
+	htmltemplate "html/template"
+	"io"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// matrixOutputLines is how many trailing lines of relayer output are included for a failed exec.
+const matrixOutputLines = 40
+
+// WriteMarkdown writes the matrix as Markdown, with a table per chain pair
+// and a section per failed test, which the failed cells link to.
+func (m *CompatibilityMatrix) WriteMarkdown(w io.Writer) error {
+	return markdownMatrixTemplate.Execute(w, m.view())
+}
+
+// WriteHTML writes the matrix as a self-contained HTML document,
+// with a table per chain pair and a section per failed test, which the failed cells link to.
+func (m *CompatibilityMatrix) WriteHTML(w io.Writer) error {
+	return htmlMatrixTemplate.Execute(w, m.view())
+}
+
+// matrixView is the data rendered by the matrix templates.
+type matrixView struct {
+	StartedAt time.Time
+
+	Relayers  []string
+	Summaries []relayerSummary
+
+	ChainPairs []chainPairView
+	Failures   []failureView
+}
+
+type relayerSummary struct {
+	Relayer                 string
+	Passed, Failed, Skipped int
+}
+
+type chainPairView struct {
+	Name string
+	Rows []matrixRow
+}
+
+type matrixRow struct {
+	TestCase string
+	Cells    []matrixCell
+}
+
+type matrixCell struct {
+	// Nil if the test case did not run.
+	Result *TestResult
+
+	// Anchor of the failure section, if the test failed.
+	FailureID string
+}
+
+type failureView struct {
+	ID                           string
+	ChainPair, Relayer, TestCase string
+	Result                       *TestResult
+}
+
+func (m *CompatibilityMatrix) view() matrixView {
+	v := matrixView{
+		StartedAt: m.StartedAt,
+		Relayers:  m.Relayers,
+	}
+
+	for _, r := range m.Relayers {
+		counts := m.Counts(r)
+		v.Summaries = append(v.Summaries, relayerSummary{
+			Relayer: r,
+			Passed:  counts[TestPassed],
+			Failed:  counts[TestFailed],
+			Skipped: counts[TestSkipped],
+		})
+	}
+
+	for _, cp := range m.ChainPairs {
+		cpv := chainPairView{Name: cp}
+		for _, tc := range m.TestCases {
+			row := matrixRow{TestCase: tc}
+			ran := false
+			for _, r := range m.Relayers {
+				var cell matrixCell
+				if res, ok := m.Result(cp, r, tc); ok {
+					ran = true
+					cell.Result = res
+					if res.Status == TestFailed {
+						cell.FailureID = "failure-" + strconv.Itoa(len(v.Failures)+1)
+						v.Failures = append(v.Failures, failureView{
+							ID:        cell.FailureID,
+							ChainPair: cp,
+							Relayer:   r,
+							TestCase:  tc,
+							Result:    res,
+						})
+					}
+				}
+				row.Cells = append(row.Cells, cell)
+			}
+			if ran {
+				cpv.Rows = append(cpv.Rows, row)
+			}
+		}
+		v.ChainPairs = append(v.ChainPairs, cpv)
+	}
+
+	return v
+}
+
+func formatMatrixDuration(d time.Duration) string {
+	if d < time.Second {
+		return d.Round(time.Millisecond).String()
+	}
+	return d.Round(time.Second).String()
+}
+
+// markdownCell escapes s for use in a Markdown table cell.
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// tailLines returns the last n lines of s.
+func tailLines(n int, s string) string {
+	lines := strings.Split(strings.TrimRight(s, "\n"), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
+}
+
+var matrixTemplateFuncs = map[string]any{
+	"duration": formatMatrixDuration,
+	"mdcell":   markdownCell,
+	"join":     strings.Join,
+	"tail":     func(s string) string { return tailLines(matrixOutputLines, s) },
+}
+
+var markdownMatrixTemplate = template.Must(template.New("markdown").Funcs(matrixTemplateFuncs).Parse(
+	`{{define "cell"}}{{with .Result}}{{if eq .Status "pass"}}✅ pass ({{duration .Duration}}){{else if eq .Status "fail"}}❌ [fail](#{{$.FailureID}}) ({{duration .Duration}}){{else}}⏭️ skip: {{if .MissingCapabilities}}missing {{join .MissingCapabilities ", "}}{{else}}{{mdcell .SkipReason}}{{end}}{{end}}{{else}}–{{end}}{{end -}}
+
+# Relayer compatibility matrix
+{{if not .StartedAt.IsZero}}
+Test suite started at {{.StartedAt.UTC.Format "2006-01-02 15:04:05 MST"}}.
+{{end}}
+| Relayer | Passed | Failed | Skipped |
+| --- | --- | --- | --- |
+{{range .Summaries}}| {{mdcell .Relayer}} | {{.Passed}} | {{.Failed}} | {{.Skipped}} |
+{{end}}{{range .ChainPairs}}
+## {{.Name}}
+
+| Test case |{{range $.Relayers}} {{mdcell .}} |{{end}}
+| --- |{{range $.Relayers}} --- |{{end}}
+{{range .Rows}}| {{mdcell .TestCase}} |{{range .Cells}} {{template "cell" .}} |{{end}}
+{{end}}{{end}}{{if .Failures}}
+## Failures
+{{range .Failures}}
+### <a id="{{.ID}}"></a>{{.Relayer}} / {{.ChainPair}} / {{.TestCase}}
+
+Test {{.Result.Name}} failed after {{duration .Result.Duration}}.
+{{range .Result.Errors}}
+~~~
+{{.}}
+~~~
+{{end}}{{range .Result.FailedExecs}}
+Relayer command in test {{.Name}} exited with code {{.ExitCode}}{{if .Error}}: {{.Error}}{{end}}
+
+~~~shell
+$ {{join .Command " "}}
+{{tail .Stderr}}
+~~~
+{{end}}{{end}}{{end}}`,
+))
+
+var htmlMatrixTemplate = htmltemplate.Must(htmltemplate.New("html").Funcs(matrixTemplateFuncs).Parse(
+	`{{define "cell"}}{{with .Result}}{{if eq .Status "pass"}}<td class="pass">pass<br><small>{{duration .Duration}}</small></td>{{else if eq .Status "fail"}}<td class="fail"><a href="#{{$.FailureID}}">fail</a><br><small>{{duration .Duration}}</small></td>{{else}}<td class="skip" title="{{.SkipReason}}">skip<br><small>{{if .MissingCapabilities}}missing {{join .MissingCapabilities ", "}}{{else}}{{.SkipReason}}{{end}}</small></td>{{end}}{{else}}<td class="none">–</td>{{end}}{{end -}}
+<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Relayer compatibility matrix</title>
+<style>
+body { font-family: sans-serif; margin: 2em; color: #222; }
+table { border-collapse: collapse; margin-bottom: 2em; }
+th, td { border: 1px solid #ccc; padding: 0.4em 0.8em; text-align: left; vertical-align: top; }
+th { background: #f4f4f4; }
+td.pass { background: #e3f6e3; }
+td.fail { background: #fbe1e1; }
+td.skip { background: #f6f1dc; }
+td.none { color: #999; }
+pre { background: #f7f7f7; border: 1px solid #ddd; padding: 0.8em; overflow-x: auto; }
+</style>
+</head>
+<body>
+<h1>Relayer compatibility matrix</h1>
+{{if not .StartedAt.IsZero}}<p>Test suite started at {{.StartedAt.UTC.Format "2006-01-02 15:04:05 MST"}}.</p>
+{{end}}<table>
+<tr><th>Relayer</th><th>Passed</th><th>Failed</th><th>Skipped</th></tr>
+{{range .Summaries}}<tr><td>{{.Relayer}}</td><td>{{.Passed}}</td><td>{{.Failed}}</td><td>{{.Skipped}}</td></tr>
+{{end}}</table>
+{{range .ChainPairs}}<h2>{{.Name}}</h2>
+<table>
+<tr><th>Test case</th>{{range $.Relayers}}<th>{{.}}</th>{{end}}</tr>
+{{range .Rows}}<tr><td>{{.TestCase}}</td>{{range .Cells}}{{template "cell" .}}{{end}}</tr>
+{{end}}</table>
+{{end}}{{if .Failures}}<h2>Failures</h2>
+{{range .Failures}}<h3 id="{{.ID}}">{{.Relayer}} / {{.ChainPair}} / {{.TestCase}}</h3>
+<p>Test <code>{{.Result.Name}}</code> failed after {{duration .Result.Duration}}.</p>
+{{range .Result.Errors}}<pre>{{.}}</pre>
+{{end}}{{range .Result.FailedExecs}}<details>
+<summary>Relayer command in test <code>{{.Name}}</code> exited with code {{.ExitCode}}{{if .Error}}: {{.Error}}{{end}}</summary>
+<pre>$ {{join .Command " "}}
+{{tail .Stderr}}</pre>
+</details>
+{{end}}{{end}}{{end}}</body>
+</html>
+`,
+))
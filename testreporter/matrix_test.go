@@ -0,0 +1,131 @@
+package testreporter_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+	"github.com/stretchr/testify/require"
+)
+
+func matrixTestMessages() []testreporter.Message {
+	start := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	at := func(s int) time.Time { return start.Add(time.Duration(s) * time.Second) }
+
+	const (
+		rly    = "TestConformance/chain_pairs/gaia+osmosis/rly"
+		hermes = "TestConformance/chain_pairs/gaia+osmosis/hermes"
+	)
+
+	return []testreporter.Message{
+		testreporter.BeginSuiteMessage{StartedAt: start},
+
+		// Parent tests are not test cases of their own.
+		testreporter.BeginTestMessage{Name: rly, StartedAt: at(0)},
+		testreporter.BeginTestMessage{Name: rly + "/conformance", StartedAt: at(0)},
+		testreporter.BeginTestMessage{Name: rly + "/conformance/post_relayer_start/relay_packet", StartedAt: at(10)},
+		testreporter.PauseTestMessage{Name: rly + "/conformance/post_relayer_start/relay_packet", When: at(10)},
+		testreporter.ContinueTestMessage{Name: rly + "/conformance/post_relayer_start/relay_packet", When: at(20)},
+		testreporter.FinishTestMessage{Name: rly + "/conformance/post_relayer_start/relay_packet", FinishedAt: at(50)},
+		testreporter.BeginTestMessage{Name: rly + "/flushing", StartedAt: at(0)},
+		testreporter.TestSkipMessage{Name: rly + "/flushing", When: at(1), Message: "skipping due to missing relayer capabilities +[Flush ChannelUpgrade]"},
+		testreporter.FinishTestMessage{Name: rly + "/flushing", FinishedAt: at(1), Skipped: true},
+		testreporter.FinishTestMessage{Name: rly + "/conformance", FinishedAt: at(50)},
+		testreporter.FinishTestMessage{Name: rly, FinishedAt: at(50)},
+
+		testreporter.BeginTestMessage{Name: hermes, StartedAt: at(0)},
+		testreporter.BeginTestMessage{Name: hermes + "/conformance", StartedAt: at(0)},
+		testreporter.RelayerExecMessage{Name: hermes + "/conformance", StartedAt: at(5), Command: []string{"hermes", "start"}, ExitCode: 0},
+		testreporter.RelayerExecMessage{Name: hermes + "/conformance", StartedAt: at(6), Command: []string{"hermes", "tx", "packet-recv"}, Stderr: "insufficient fees | retrying", ExitCode: 1},
+		testreporter.BeginTestMessage{Name: hermes + "/conformance/post_relayer_start/relay_packet", StartedAt: at(10)},
+		testreporter.TestErrorMessage{Name: hermes + "/conformance/post_relayer_start/relay_packet", When: at(40), Message: "failed to get acknowledgement on source chain"},
+		testreporter.FinishTestMessage{Name: hermes + "/conformance/post_relayer_start/relay_packet", FinishedAt: at(40), Failed: true},
+		testreporter.FinishTestMessage{Name: hermes + "/conformance", FinishedAt: at(40), Failed: true},
+		testreporter.FinishTestMessage{Name: hermes, FinishedAt: at(40), Failed: true},
+
+		// Tests outside of the chain pairs are ignored.
+		testreporter.BeginTestMessage{Name: "TestMainFlags_Logger", StartedAt: at(0)},
+		testreporter.FinishTestMessage{Name: "TestMainFlags_Logger", FinishedAt: at(1)},
+
+		testreporter.FinishSuiteMessage{FinishedAt: at(60)},
+	}
+}
+
+func TestCompatibilityMatrix(t *testing.T) {
+	t.Parallel()
+
+	m := testreporter.NewCompatibilityMatrix(matrixTestMessages())
+
+	require.Equal(t, []string{"hermes", "rly"}, m.Relayers)
+	require.Equal(t, []string{"gaia+osmosis"}, m.ChainPairs)
+	require.Equal(t, []string{"conformance/relay_packet", "flushing"}, m.TestCases)
+
+	res, ok := m.Result("gaia+osmosis", "rly", "conformance/relay_packet")
+	require.True(t, ok)
+	require.Equal(t, testreporter.TestPassed, res.Status)
+	require.Equal(t, 30*time.Second, res.Duration) // Excludes the 10s paused.
+
+	res, ok = m.Result("gaia+osmosis", "rly", "flushing")
+	require.True(t, ok)
+	require.Equal(t, testreporter.TestSkipped, res.Status)
+	require.Equal(t, []string{"Flush", "ChannelUpgrade"}, res.MissingCapabilities)
+
+	res, ok = m.Result("gaia+osmosis", "hermes", "conformance/relay_packet")
+	require.True(t, ok)
+	require.Equal(t, testreporter.TestFailed, res.Status)
+	require.Equal(t, []string{"failed to get acknowledgement on source chain"}, res.Errors)
+	require.Len(t, res.FailedExecs, 1)
+	require.Equal(t, []string{"hermes", "tx", "packet-recv"}, res.FailedExecs[0].Command)
+
+	_, ok = m.Result("gaia+osmosis", "hermes", "flushing")
+	require.False(t, ok)
+
+	require.Equal(t, map[testreporter.TestStatus]int{testreporter.TestPassed: 1, testreporter.TestSkipped: 1}, m.Counts("rly"))
+}
+
+func TestCompatibilityMatrix_WriteMarkdown(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, testreporter.NewCompatibilityMatrix(matrixTestMessages()).WriteMarkdown(&buf))
+	out := buf.String()
+
+	require.Contains(t, out, "| Test case | hermes | rly |\n")
+	require.Contains(t, out, "| conformance/relay_packet | ❌ [fail](#failure-1) (30s) | ✅ pass (30s) |\n")
+	require.Contains(t, out, "| flushing | – | ⏭️ skip: missing Flush, ChannelUpgrade |\n")
+	require.Contains(t, out, `### <a id="failure-1"></a>hermes / gaia+osmosis / conformance/relay_packet`)
+	require.Contains(t, out, "$ hermes tx packet-recv\ninsufficient fees | retrying\n")
+}
+
+func TestCompatibilityMatrix_WriteHTML(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, testreporter.NewCompatibilityMatrix(matrixTestMessages()).WriteHTML(&buf))
+	out := buf.String()
+
+	require.True(t, strings.HasPrefix(out, "<!DOCTYPE html>"))
+	require.Contains(t, out, `<td class="fail"><a href="#failure-1">fail</a>`)
+	require.Contains(t, out, `<h3 id="failure-1">hermes / gaia&#43;osmosis / conformance/relay_packet</h3>`)
+	require.Contains(t, out, "missing Flush, ChannelUpgrade")
+}
+
+func TestReadMessages(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, m := range matrixTestMessages() {
+		require.NoError(t, enc.Encode(testreporter.JSONMessage(m)))
+	}
+
+	msgs, err := testreporter.ReadMessages(&buf)
+	require.NoError(t, err)
+	require.Len(t, msgs, len(matrixTestMessages()))
+
+	_, err = testreporter.ReadMessages(strings.NewReader(`{"Type":"Unknown","Message":{}}`))
+	require.Error(t, err)
+}
@@ -133,6 +133,30 @@ func (m RelayerExecMessage) typ() string {
 	return "RelayerExec"
 }
 
+// RelayerMetricsMessage is a time series of metrics scraped from a relayer.
+// This message is populated through the RelayerExecReporter type.
+type RelayerMetricsMessage struct {
+	Name string // Test name, but "Name" for consistency.
+
+	Relayer string
+
+	Samples []RelayerMetricSample
+}
+
+// RelayerMetricSample is the value of a single relayer metric at a point in time.
+type RelayerMetricSample struct {
+	When time.Time
+
+	Metric string
+	Labels map[string]string `json:",omitempty"`
+
+	Value float64
+}
+
+func (m RelayerMetricsMessage) typ() string {
+	return "RelayerMetrics"
+}
+
 // WrappedMessage wraps a Message with an outer Type field
 // so that decoders can determine the underlying message's type.
 type WrappedMessage struct {
@@ -199,6 +223,10 @@ func (m *WrappedMessage) UnmarshalJSON(b []byte) error {
 		x := RelayerExecMessage{}
 		err = json.Unmarshal(raw, &x)
 		msg = x
+	case "RelayerMetrics":
+		x := RelayerMetricsMessage{}
+		err = json.Unmarshal(raw, &x)
+		msg = x
 	default:
 		return fmt.Errorf("unknown message type %q", outer.Type)
 	}
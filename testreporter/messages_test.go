@@ -39,6 +39,16 @@ func TestWrappedMessage_RoundTrip(t *testing.T) {
 				Error:         "",
 			},
 		},
+		{
+			Message: testreporter.RelayerMetricsMessage{
+				Name:    "foo",
+				Relayer: "rly",
+				Samples: []testreporter.RelayerMetricSample{
+					{When: time.Now(), Metric: "cosmos_relayer_relayed_packets", Labels: map[string]string{"chain": "gaia"}, Value: 3},
+					{When: time.Now(), Metric: "cosmos_relayer_tx_errors_total", Value: 0},
+				},
+			},
+		},
 	}
 
 	for _, tc := range tcs {
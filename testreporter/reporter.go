@@ -156,6 +156,15 @@ func (r *RelayerExecReporter) TrackRelayerExec(
 	}
 }
 
+// TrackRelayerMetrics tracks a time series of metrics scraped from the named relayer.
+func (r *RelayerExecReporter) TrackRelayerMetrics(relayerName string, samples []RelayerMetricSample) {
+	r.r.in <- RelayerMetricsMessage{
+		Name:    r.testName,
+		Relayer: relayerName,
+		Samples: samples,
+	}
+}
+
 // TestifyT returns a TestifyReporter which will track logged errors in test.
 // Typically you will use this with the New method on the require or assert package:
 //
@@ -0,0 +1,54 @@
+package testutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+)
+
+// ClientStatusGetter is a relayer that can report the status of the clients on a path.
+type ClientStatusGetter interface {
+	GetClientStatus(ctx context.Context, rep ibc.RelayerExecReporter, pathName, chainID string) (ibc.ClientStatusOutput, error)
+}
+
+// clientStatusPollInterval is the delay between status queries once the trusting period has elapsed.
+var clientStatusPollInterval = time.Second
+
+// WaitForClientExpiry blocks until the client on chainID for pathName has outlived its trusting period
+// and is reported as expired. opts must be the options the client was created with,
+// and must set TrustingPeriod so that the wait is bounded, e.g. "30s".
+//
+// Nothing may update the client while waiting,
+// so the relayer must have been paused with PauseRelayer or not started at all.
+// Polling the status continues until ctx is done.
+func WaitForClientExpiry(ctx context.Context, r ClientStatusGetter, rep ibc.RelayerExecReporter, pathName, chainID string, opts ibc.CreateClientOptions) error {
+	if opts.TrustingPeriod == "" {
+		return errors.New("client options must set a trusting period to expire a client")
+	}
+	trustingPeriod, err := time.ParseDuration(opts.TrustingPeriod)
+	if err != nil {
+		return fmt.Errorf("invalid trusting period %q: %w", opts.TrustingPeriod, err)
+	}
+
+	// The client was last updated no later than now, so it expires within one trusting period.
+	delay := trustingPeriod
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("client on %s for path %s did not expire: %w", chainID, pathName, ctx.Err())
+		case <-time.After(delay):
+		}
+		delay = clientStatusPollInterval
+
+		status, err := r.GetClientStatus(ctx, rep, pathName, chainID)
+		if err != nil {
+			return fmt.Errorf("failed to get client status: %w", err)
+		}
+		if status.Status == ibc.ClientStatusExpired {
+			return nil
+		}
+	}
+}
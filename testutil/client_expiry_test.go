@@ -0,0 +1,71 @@
+package testutil
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/stretchr/testify/require"
+)
+
+type mockClientStatusGetter struct {
+	Statuses []ibc.ClientStatus
+	Err      error
+
+	calls int
+}
+
+func (m *mockClientStatusGetter) GetClientStatus(ctx context.Context, rep ibc.RelayerExecReporter, pathName, chainID string) (ibc.ClientStatusOutput, error) {
+	if ctx == nil {
+		panic("nil context")
+	}
+	status := m.Statuses[min(m.calls, len(m.Statuses)-1)]
+	m.calls++
+	return ibc.ClientStatusOutput{ClientID: "07-tendermint-0", ChainID: chainID, Status: status}, m.Err
+}
+
+func TestWaitForClientExpiry(t *testing.T) {
+	clientStatusPollInterval = time.Millisecond
+	t.Cleanup(func() { clientStatusPollInterval = time.Second })
+
+	ctx := context.Background()
+	opts := ibc.CreateClientOptions{TrustingPeriod: "1ms"}
+
+	t.Run("happy path", func(t *testing.T) {
+		r := &mockClientStatusGetter{Statuses: []ibc.ClientStatus{ibc.ClientStatusActive, ibc.ClientStatusActive, ibc.ClientStatusExpired}}
+
+		err := WaitForClientExpiry(ctx, r, ibc.NopRelayerExecReporter{}, "p", "chain-a", opts)
+
+		require.NoError(t, err)
+		require.Equal(t, 3, r.calls)
+	})
+
+	t.Run("missing trusting period", func(t *testing.T) {
+		r := &mockClientStatusGetter{Statuses: []ibc.ClientStatus{ibc.ClientStatusExpired}}
+
+		err := WaitForClientExpiry(ctx, r, ibc.NopRelayerExecReporter{}, "p", "chain-a", ibc.CreateClientOptions{})
+
+		require.Error(t, err)
+		require.Zero(t, r.calls)
+	})
+
+	t.Run("status error", func(t *testing.T) {
+		r := &mockClientStatusGetter{Statuses: []ibc.ClientStatus{ibc.ClientStatusUnknown}, Err: errors.New("boom")}
+
+		err := WaitForClientExpiry(ctx, r, ibc.NopRelayerExecReporter{}, "p", "chain-a", opts)
+
+		require.ErrorContains(t, err, "boom")
+	})
+
+	t.Run("context done", func(t *testing.T) {
+		r := &mockClientStatusGetter{Statuses: []ibc.ClientStatus{ibc.ClientStatusActive}}
+		cctx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+		defer cancel()
+
+		err := WaitForClientExpiry(cctx, r, ibc.NopRelayerExecReporter{}, "p", "chain-a", opts)
+
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
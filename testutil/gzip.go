@@ -3,6 +3,7 @@ package testutil
 import (
 	"bytes"
 	"compress/gzip"
+	"io"
 )
 
 // GzipIt compresses the input ([]byte)
@@ -21,3 +22,13 @@ func GzipIt(input []byte) ([]byte, error) {
 
 	return b.Bytes(), nil
 }
+
+// GunzipIt decompresses the output of GzipIt.
+func GunzipIt(input []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(input))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
@@ -0,0 +1,105 @@
+package interchaintest
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+)
+
+// WasmClientCode describes an 08-wasm light client contract to store on a chain during Build.
+type WasmClientCode struct {
+	// Chain hosting the wasm light client. It must be one of the two chains of the link,
+	// and a *cosmos.CosmosChain whose EncodingConfig registers the 08-wasm types.
+	Chain ibc.Chain
+
+	// Path to the compiled light client contract.
+	File string
+}
+
+// wasmClientProposalBlocks is how many blocks Build waits for a store code proposal to pass.
+// Chains hosting a wasm light client should therefore be configured with a short voting period.
+const wasmClientProposalBlocks = 30
+
+// storeWasmClientCode stores the wasm light client code of every link on its chain,
+// and hands the resulting checksum to the link's relayer.
+// Code shared by several links is only stored once.
+func (ic *Interchain) storeWasmClientCode(ctx context.Context, rep *testreporter.RelayerExecReporter) error {
+	checksums := make(map[WasmClientCode]string)
+	for rp, link := range ic.links {
+		code := link.wasmClientCode
+		if code.File == "" {
+			continue
+		}
+
+		checksum, ok := checksums[code]
+		if !ok {
+			var err error
+			checksum, err = storeWasmClientCode(ctx, code)
+			if err != nil {
+				return fmt.Errorf("failed to store wasm client code %s on chain %s: %w", code.File, ic.chains[code.Chain], err)
+			}
+			checksums[code] = checksum
+		}
+
+		if err := rp.Relayer.SetClientContractHash(ctx, rep, code.Chain.Config(), checksum); err != nil {
+			return fmt.Errorf(
+				"failed to set wasm client checksum for chain %s on relayer %s: %w",
+				ic.chains[code.Chain], ic.relayers[rp.Relayer], err,
+			)
+		}
+	}
+	return nil
+}
+
+// storeWasmClientCode submits a governance proposal storing the wasm light client code,
+// votes yes with all validators and waits for the proposal to pass.
+// It returns the hex encoded checksum of the stored code.
+func storeWasmClientCode(ctx context.Context, code WasmClientCode) (string, error) {
+	c, ok := code.Chain.(*cosmos.CosmosChain)
+	if !ok {
+		return "", fmt.Errorf("chain type %s does not support wasm light clients", code.Chain.Config().Type)
+	}
+
+	params, err := c.GovQueryParams(ctx, "deposit")
+	if err != nil {
+		return "", fmt.Errorf("failed to query gov params: %w", err)
+	}
+
+	height, err := c.Height(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get chain height: %w", err)
+	}
+
+	prop := cosmos.TxProposalv1{
+		Metadata: "none",
+		Deposit:  sdk.NewCoins(params.MinDeposit...).String(),
+		Title:    "Store wasm light client",
+		Summary:  "Store wasm light client " + filepath.Base(code.File),
+	}
+	tx, checksum, err := c.PushNewWasmClientProposal(ctx, FaucetAccountKeyName, code.File, prop)
+	if err != nil {
+		return "", err
+	}
+
+	if err := c.VoteOnProposalAllValidators(ctx, tx.ProposalID, cosmos.ProposalVoteYes); err != nil {
+		return "", fmt.Errorf("failed to vote on proposal %s: %w", tx.ProposalID, err)
+	}
+
+	propID, err := strconv.ParseUint(tx.ProposalID, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse proposal ID %s: %w", tx.ProposalID, err)
+	}
+
+	if _, err := cosmos.PollForProposalStatusV1(ctx, c, height, height+wasmClientProposalBlocks, propID, govv1.StatusPassed); err != nil {
+		return "", fmt.Errorf("proposal %d did not pass: %w", propID, err)
+	}
+
+	return checksum, nil
+}